@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var bucketName = []byte("responses")
+
+// BoltCache is a file-backed Cache built on BoltDB, so a long-running
+// process's cache survives restarts.
+type BoltCache struct {
+	db *bolt.DB
+}
+
+// record is what gets stored per key: the cached body and its expiry.
+type record struct {
+	Body      []byte    `json:"body"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewBoltCache opens (creating if necessary) a BoltDB-backed cache at path.
+func NewBoltCache(path string) (*BoltCache, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketName)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltCache{db: db}, nil
+}
+
+// Get implements Cache.
+func (c *BoltCache) Get(key string) ([]byte, bool) {
+	var rec record
+	found := false
+	_ = c.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(bucketName).Get([]byte(key))
+		if raw == nil {
+			return nil
+		}
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return nil
+		}
+		found = true
+		return nil
+	})
+	if !found || time.Now().After(rec.ExpiresAt) {
+		return nil, false
+	}
+	return rec.Body, true
+}
+
+// Set implements Cache.
+func (c *BoltCache) Set(key string, body []byte, ttl time.Duration) {
+	rec := record{Body: body, ExpiresAt: time.Now().Add(ttl)}
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+	_ = c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketName).Put([]byte(key), raw)
+	})
+}
+
+// Purge implements the optional purger interface used by PurgeCache.
+func (c *BoltCache) Purge() error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(bucketName); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(bucketName)
+		return err
+	})
+}
+
+// Close releases the underlying BoltDB file handle.
+func (c *BoltCache) Close() error {
+	return c.db.Close()
+}
@@ -0,0 +1,16 @@
+// Package cache defines a pluggable response cache, letting long-running
+// bots and TUIs built on this module survive restarts without re-hammering
+// CMC and hitting the free-tier credit ceiling.
+package cache
+
+import "time"
+
+// Cache stores raw response bodies keyed by request URL (including query
+// string), each with its own expiry.
+type Cache interface {
+	// Get returns the cached body for key and true, or (nil, false) if
+	// there is no entry or it has expired.
+	Get(key string) ([]byte, bool)
+	// Set stores body under key for ttl.
+	Set(key string, body []byte, ttl time.Duration)
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUGetSet(t *testing.T) {
+	c := NewLRU(2)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("Get on empty cache returned ok = true")
+	}
+
+	c.Set("a", []byte("1"), time.Minute)
+	body, ok := c.Get("a")
+	if !ok || string(body) != "1" {
+		t.Fatalf("Get(a) = %q, %v, want %q, true", body, ok, "1")
+	}
+}
+
+func TestLRUExpiry(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"), -time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("Get on an expired entry returned ok = true")
+	}
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), time.Minute)
+	c.Get("a") // a is now more recently used than b
+	c.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("Get(b) ok = true, want b to have been evicted as least recently used")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("Get(a) ok = false, want a to still be cached")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Error("Get(c) ok = false, want c to still be cached")
+	}
+}
+
+func TestLRUSetOverwritesExistingKey(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("a", []byte("2"), time.Minute)
+
+	body, ok := c.Get("a")
+	if !ok || string(body) != "2" {
+		t.Fatalf("Get(a) = %q, %v, want %q, true", body, ok, "2")
+	}
+}
+
+func TestLRUPurge(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"), time.Minute)
+	if err := c.Purge(); err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get(a) ok = true after Purge")
+	}
+}
@@ -0,0 +1,178 @@
+// Package v1 is a client for the CoinMarketCap Pro API v1
+// (https://pro-api.coinmarketcap.com/v1/). Unlike the scraped/public v2
+// package, every request here is authenticated with a Pro API key and
+// counts against that key's credit budget.
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBaseURL = "https://pro-api.coinmarketcap.com/v1/"
+	apiKeyHeader   = "X-CMC_PRO_API_KEY"
+)
+
+// Config holds the options used to construct a Client.
+type Config struct {
+	// ProAPIKey is the CoinMarketCap Pro API key sent on every request. When
+	// empty, NewClient falls back to the CMC_PRO_API_KEY environment
+	// variable.
+	ProAPIKey string
+	// HTTPClient is the HTTP client used to make requests. Defaults to a
+	// client configured with Timeout when nil.
+	HTTPClient *http.Client
+	// Timeout bounds each request. Ignored when HTTPClient is set.
+	Timeout time.Duration
+}
+
+// RateLimit captures the X-RateLimit-* headers of the most recently
+// received response.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// ErrCreditsExhausted is returned instead of making a request when the last
+// observed rate limit window reported no credits remaining and has not yet
+// reset.
+var ErrCreditsExhausted = errors.New("cmc/pro: credit budget exhausted, refusing request until rate limit window resets")
+
+// Client is a CoinMarketCap Pro API v1 client.
+type Client struct {
+	apiKey     string
+	httpClient *http.Client
+	baseURL    string
+
+	mu        sync.RWMutex
+	rateLimit RateLimit
+
+	Cryptocurrency *CryptocurrencyService
+	Exchange       *ExchangeService
+	GlobalMetrics  *GlobalMetricsService
+	Tools          *ToolsService
+}
+
+// NewClient builds a Pro API client from cfg. cfg may be nil, in which case
+// the client relies entirely on the CMC_PRO_API_KEY environment variable and
+// http.DefaultClient's defaults.
+func NewClient(cfg *Config) *Client {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	apiKey := cfg.ProAPIKey
+	if apiKey == "" {
+		apiKey = os.Getenv("CMC_PRO_API_KEY")
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	c := &Client{
+		apiKey:     apiKey,
+		httpClient: httpClient,
+		baseURL:    defaultBaseURL,
+	}
+	c.Cryptocurrency = &CryptocurrencyService{client: c}
+	c.Exchange = &ExchangeService{client: c}
+	c.GlobalMetrics = &GlobalMetricsService{client: c}
+	c.Tools = &ToolsService{client: c}
+	return c
+}
+
+// RateLimit returns the rate limit state observed on the last response. The
+// zero value means no response has been seen yet.
+func (c *Client) RateLimit() RateLimit {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rateLimit
+}
+
+// get issues an authenticated GET against path, decodes the standard
+// {status, data} envelope and unmarshals its data field into out.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	c.mu.RLock()
+	rl := c.rateLimit
+	c.mu.RUnlock()
+	if rl.Remaining <= 0 && !rl.Reset.IsZero() && time.Now().Before(rl.Reset) {
+		return ErrCreditsExhausted
+	}
+
+	reqURL := c.baseURL + strings.TrimPrefix(path, "/")
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(apiKeyHeader, c.apiKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	c.updateRateLimit(resp.Header)
+
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return fmt.Errorf("cmc/pro: decoding response: %w", err)
+	}
+	if env.Status.ErrorCode != 0 {
+		return &APIError{Code: env.Status.ErrorCode, Message: env.Status.ErrorMessage}
+	}
+	if out == nil || len(env.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(env.Data, out)
+}
+
+func (c *Client) updateRateLimit(h http.Header) {
+	limit, hasLimit := parseIntHeader(h, "X-RateLimit-Limit")
+	remaining, hasRemaining := parseIntHeader(h, "X-RateLimit-Remaining")
+	if !hasLimit && !hasRemaining {
+		return
+	}
+	var reset time.Time
+	if secs := h.Get("X-RateLimit-Reset"); secs != "" {
+		if n, err := strconv.ParseInt(secs, 10, 64); err == nil {
+			reset = time.Unix(n, 0)
+		}
+	}
+
+	c.mu.Lock()
+	c.rateLimit = RateLimit{Limit: limit, Remaining: remaining, Reset: reset}
+	c.mu.Unlock()
+}
+
+func parseIntHeader(h http.Header, key string) (int, bool) {
+	v := h.Get(key)
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
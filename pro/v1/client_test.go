@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseIntHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", "30")
+	h.Set("X-RateLimit-Remaining", "not-a-number")
+
+	if n, ok := parseIntHeader(h, "X-RateLimit-Limit"); !ok || n != 30 {
+		t.Errorf("parseIntHeader(Limit) = %d, %v, want 30, true", n, ok)
+	}
+	if n, ok := parseIntHeader(h, "X-RateLimit-Remaining"); ok {
+		t.Errorf("parseIntHeader(Remaining) = %d, %v, want ok = false for a non-numeric header", n, ok)
+	}
+	if n, ok := parseIntHeader(h, "X-RateLimit-Reset"); ok {
+		t.Errorf("parseIntHeader(Reset) = %d, %v, want ok = false for a missing header", n, ok)
+	}
+}
+
+func TestUpdateRateLimit(t *testing.T) {
+	c := NewClient(&Config{ProAPIKey: "k"})
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", "30")
+	h.Set("X-RateLimit-Remaining", "5")
+	h.Set("X-RateLimit-Reset", "1700000000")
+
+	c.updateRateLimit(h)
+
+	rl := c.RateLimit()
+	if rl.Limit != 30 || rl.Remaining != 5 {
+		t.Errorf("RateLimit() = %+v, want Limit=30 Remaining=5", rl)
+	}
+	if want := time.Unix(1700000000, 0); !rl.Reset.Equal(want) {
+		t.Errorf("RateLimit().Reset = %v, want %v", rl.Reset, want)
+	}
+}
+
+func TestUpdateRateLimitIgnoresResponseWithoutRateLimitHeaders(t *testing.T) {
+	c := NewClient(&Config{ProAPIKey: "k"})
+	c.updateRateLimit(http.Header{"X-RateLimit-Limit": {"30"}, "X-RateLimit-Remaining": {"5"}})
+	before := c.RateLimit()
+
+	c.updateRateLimit(http.Header{"Content-Type": {"application/json"}})
+
+	if got := c.RateLimit(); got != before {
+		t.Errorf("RateLimit() = %+v after a response with no rate-limit headers, want unchanged %+v", got, before)
+	}
+}
+
+func TestGetRefusesRequestWhenCreditsExhausted(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+	c.rateLimit = RateLimit{Limit: 30, Remaining: 0, Reset: time.Now().Add(time.Hour)}
+
+	err := c.get(context.Background(), "cryptocurrency/map", nil, nil)
+	if err != ErrCreditsExhausted {
+		t.Fatalf("get() error = %v, want ErrCreditsExhausted", err)
+	}
+	if requests != 0 {
+		t.Errorf("requests = %d, want 0: exhausted credits should short-circuit before hitting the network", requests)
+	}
+}
+
+func TestGetAllowsRequestAfterResetWindowPasses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+	c.rateLimit = RateLimit{Limit: 30, Remaining: 0, Reset: time.Now().Add(-time.Second)}
+
+	if err := c.get(context.Background(), "cryptocurrency/map", nil, nil); err != nil {
+		t.Fatalf("get() error = %v, want nil once the reset window has passed", err)
+	}
+}
@@ -0,0 +1,426 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CryptocurrencyService groups the /v1/cryptocurrency/* endpoints.
+type CryptocurrencyService struct {
+	client *Client
+}
+
+// CryptocurrencyInfoOptions selects which coins Info describes. At least one
+// of ID, Symbol or Slug must be set.
+type CryptocurrencyInfoOptions struct {
+	ID     []int
+	Symbol []string
+	Slug   []string
+}
+
+// CryptocurrencyInfo is static metadata about a cryptocurrency.
+type CryptocurrencyInfo struct {
+	ID          int                 `json:"id"`
+	Name        string              `json:"name"`
+	Symbol      string              `json:"symbol"`
+	Slug        string              `json:"slug"`
+	Description string              `json:"description"`
+	Logo        string              `json:"logo"`
+	Category    string              `json:"category"`
+	DateAdded   time.Time           `json:"date_added"`
+	Urls        map[string][]string `json:"urls"`
+}
+
+func (o *CryptocurrencyInfoOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if len(o.ID) > 0 {
+		q.Set("id", joinInts(o.ID))
+	}
+	if len(o.Symbol) > 0 {
+		q.Set("symbol", strings.Join(o.Symbol, ","))
+	}
+	if len(o.Slug) > 0 {
+		q.Set("slug", strings.Join(o.Slug, ","))
+	}
+	return q
+}
+
+// Info returns metadata for the coins identified by opts.
+func (s *CryptocurrencyService) Info(ctx context.Context, opts *CryptocurrencyInfoOptions) (map[string]*CryptocurrencyInfo, error) {
+	var out map[string]*CryptocurrencyInfo
+	err := s.client.get(ctx, "cryptocurrency/info", opts.query(), &out)
+	return out, err
+}
+
+// CryptocurrencyMapOptions filters the coin map.
+type CryptocurrencyMapOptions struct {
+	ListingStatus string
+	Start         int
+	Limit         int
+	Symbol        []string
+}
+
+// CryptocurrencyMapItem is one entry of the id<->symbol<->slug map.
+type CryptocurrencyMapItem struct {
+	ID                  int       `json:"id"`
+	Name                string    `json:"name"`
+	Symbol              string    `json:"symbol"`
+	Slug                string    `json:"slug"`
+	IsActive            int       `json:"is_active"`
+	FirstHistoricalData time.Time `json:"first_historical_data"`
+	LastHistoricalData  time.Time `json:"last_historical_data"`
+}
+
+// Map returns the full CoinMarketCap id<->symbol<->slug map, optionally
+// filtered by opts.
+func (s *CryptocurrencyService) Map(ctx context.Context, opts *CryptocurrencyMapOptions) ([]*CryptocurrencyMapItem, error) {
+	q := url.Values{}
+	if opts != nil {
+		if opts.ListingStatus != "" {
+			q.Set("listing_status", opts.ListingStatus)
+		}
+		if opts.Start > 0 {
+			q.Set("start", strconv.Itoa(opts.Start))
+		}
+		if opts.Limit > 0 {
+			q.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if len(opts.Symbol) > 0 {
+			q.Set("symbol", strings.Join(opts.Symbol, ","))
+		}
+	}
+
+	var out []*CryptocurrencyMapItem
+	err := s.client.get(ctx, "cryptocurrency/map", q, &out)
+	return out, err
+}
+
+// CryptocurrencyListingsOptions controls LatestListings/HistoricalListings.
+type CryptocurrencyListingsOptions struct {
+	Start   int
+	Limit   int
+	Convert string
+	Sort    string
+	// Date restricts HistoricalListings to a single snapshot; ignored by
+	// LatestListings.
+	Date time.Time
+}
+
+// CryptocurrencyListing is one ranked entry returned by the listings
+// endpoints.
+type CryptocurrencyListing struct {
+	ID      int              `json:"id"`
+	Name    string           `json:"name"`
+	Symbol  string           `json:"symbol"`
+	Slug    string           `json:"slug"`
+	CMCRank int              `json:"cmc_rank"`
+	Quotes  map[string]Quote `json:"quote"`
+}
+
+func (o *CryptocurrencyListingsOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if o.Start > 0 {
+		q.Set("start", strconv.Itoa(o.Start))
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	if o.Sort != "" {
+		q.Set("sort", o.Sort)
+	}
+	return q
+}
+
+// LatestListings returns cryptocurrencies ranked by the latest market data.
+func (s *CryptocurrencyService) LatestListings(ctx context.Context, opts *CryptocurrencyListingsOptions) ([]*CryptocurrencyListing, error) {
+	var out []*CryptocurrencyListing
+	err := s.client.get(ctx, "cryptocurrency/listings/latest", opts.query(), &out)
+	return out, err
+}
+
+// HistoricalListings returns a ranked snapshot as of opts.Date.
+func (s *CryptocurrencyService) HistoricalListings(ctx context.Context, opts *CryptocurrencyListingsOptions) ([]*CryptocurrencyListing, error) {
+	q := opts.query()
+	if opts != nil && !opts.Date.IsZero() {
+		q.Set("date", opts.Date.Format(time.RFC3339))
+	}
+	var out []*CryptocurrencyListing
+	err := s.client.get(ctx, "cryptocurrency/listings/historical", q, &out)
+	return out, err
+}
+
+// CryptocurrencyQuotesOptions selects coins by ID or Symbol for the quotes
+// endpoints. Exactly one of ID or Symbol should be set.
+type CryptocurrencyQuotesOptions struct {
+	ID      []int
+	Symbol  []string
+	Convert string
+}
+
+// CryptocurrencyQuote is a coin and its market data in one or more
+// currencies.
+type CryptocurrencyQuote struct {
+	ID     int              `json:"id"`
+	Name   string           `json:"name"`
+	Symbol string           `json:"symbol"`
+	Quotes map[string]Quote `json:"quote"`
+}
+
+func (o *CryptocurrencyQuotesOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if len(o.ID) > 0 {
+		q.Set("id", joinInts(o.ID))
+	}
+	if len(o.Symbol) > 0 {
+		q.Set("symbol", strings.Join(o.Symbol, ","))
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	return q
+}
+
+// LatestQuotes returns the latest market data for the coins in opts, keyed
+// by symbol.
+func (s *CryptocurrencyService) LatestQuotes(ctx context.Context, opts *CryptocurrencyQuotesOptions) (map[string]*CryptocurrencyQuote, error) {
+	var out map[string]*CryptocurrencyQuote
+	err := s.client.get(ctx, "cryptocurrency/quotes/latest", opts.query(), &out)
+	return out, err
+}
+
+// HistoricalQuotesOptions controls the historical quotes endpoint.
+type HistoricalQuotesOptions struct {
+	ID        []int
+	Symbol    []string
+	TimeStart time.Time
+	TimeEnd   time.Time
+	Count     int
+	Interval  string
+	Convert   string
+}
+
+// HistoricalQuotePoint is one timestamped quote in a historical series.
+type HistoricalQuotePoint struct {
+	Timestamp time.Time        `json:"timestamp"`
+	Quote     map[string]Quote `json:"quote"`
+}
+
+// CryptocurrencyHistoricalQuote is a coin's historical quote series.
+type CryptocurrencyHistoricalQuote struct {
+	ID     int                    `json:"id"`
+	Name   string                 `json:"name"`
+	Symbol string                 `json:"symbol"`
+	Quotes []HistoricalQuotePoint `json:"quotes"`
+}
+
+func (o *HistoricalQuotesOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if len(o.ID) > 0 {
+		q.Set("id", joinInts(o.ID))
+	}
+	if len(o.Symbol) > 0 {
+		q.Set("symbol", strings.Join(o.Symbol, ","))
+	}
+	if !o.TimeStart.IsZero() {
+		q.Set("time_start", o.TimeStart.Format(time.RFC3339))
+	}
+	if !o.TimeEnd.IsZero() {
+		q.Set("time_end", o.TimeEnd.Format(time.RFC3339))
+	}
+	if o.Count > 0 {
+		q.Set("count", strconv.Itoa(o.Count))
+	}
+	if o.Interval != "" {
+		q.Set("interval", o.Interval)
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	return q
+}
+
+// HistoricalQuotes returns interval-spaced historical market data for the
+// coins in opts.
+func (s *CryptocurrencyService) HistoricalQuotes(ctx context.Context, opts *HistoricalQuotesOptions) (map[string]*CryptocurrencyHistoricalQuote, error) {
+	var out map[string]*CryptocurrencyHistoricalQuote
+	err := s.client.get(ctx, "cryptocurrency/quotes/historical", opts.query(), &out)
+	return out, err
+}
+
+// MarketPairsOptions controls MarketPairsLatest.
+type MarketPairsOptions struct {
+	ID      int
+	Symbol  string
+	Start   int
+	Limit   int
+	Convert string
+}
+
+// MarketPair is one exchange/pair combination a coin trades on.
+type MarketPair struct {
+	Exchange struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+		Slug string `json:"slug"`
+	} `json:"exchange"`
+	MarketPair string           `json:"market_pair"`
+	Quotes     map[string]Quote `json:"quote"`
+}
+
+// MarketPairsLatest is the response shape for MarketPairsLatest.
+type MarketPairsLatest struct {
+	ID             int          `json:"id"`
+	Name           string       `json:"name"`
+	Symbol         string       `json:"symbol"`
+	NumMarketPairs int          `json:"num_market_pairs"`
+	MarketPairs    []MarketPair `json:"market_pairs"`
+}
+
+func (o *MarketPairsOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if o.ID != 0 {
+		q.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.Symbol != "" {
+		q.Set("symbol", o.Symbol)
+	}
+	if o.Start > 0 {
+		q.Set("start", strconv.Itoa(o.Start))
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	return q
+}
+
+// MarketPairsLatest returns the latest market pairs a coin trades on.
+func (s *CryptocurrencyService) MarketPairsLatest(ctx context.Context, opts *MarketPairsOptions) (*MarketPairsLatest, error) {
+	var out *MarketPairsLatest
+	err := s.client.get(ctx, "cryptocurrency/market-pairs/latest", opts.query(), &out)
+	return out, err
+}
+
+// OHLCVQuote is a coin and its OHLCV data in one or more currencies.
+type OHLCVQuote struct {
+	ID     int              `json:"id"`
+	Name   string           `json:"name"`
+	Symbol string           `json:"symbol"`
+	Quotes map[string]OHLCV `json:"quote"`
+}
+
+// OHLCVOptions controls OHLCVLatest.
+type OHLCVOptions struct {
+	ID      []int
+	Symbol  []string
+	Convert string
+}
+
+func (o *OHLCVOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if len(o.ID) > 0 {
+		q.Set("id", joinInts(o.ID))
+	}
+	if len(o.Symbol) > 0 {
+		q.Set("symbol", strings.Join(o.Symbol, ","))
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	return q
+}
+
+// OHLCVLatest returns the latest daily OHLCV for the coins in opts.
+func (s *CryptocurrencyService) OHLCVLatest(ctx context.Context, opts *OHLCVOptions) (map[string]*OHLCVQuote, error) {
+	var out map[string]*OHLCVQuote
+	err := s.client.get(ctx, "cryptocurrency/ohlcv/latest", opts.query(), &out)
+	return out, err
+}
+
+// OHLCVHistoricalOptions controls OHLCVHistorical.
+type OHLCVHistoricalOptions struct {
+	ID        []int
+	Symbol    []string
+	TimeStart time.Time
+	TimeEnd   time.Time
+	Count     int
+	Interval  string
+	Convert   string
+}
+
+// OHLCVPoint is one interval of a historical OHLCV series.
+type OHLCVPoint struct {
+	TimeOpen  time.Time        `json:"time_open"`
+	TimeClose time.Time        `json:"time_close"`
+	Quotes    map[string]OHLCV `json:"quote"`
+}
+
+// OHLCVHistorical is the response shape for OHLCVHistorical.
+type OHLCVHistorical struct {
+	ID     int          `json:"id"`
+	Name   string       `json:"name"`
+	Symbol string       `json:"symbol"`
+	Quotes []OHLCVPoint `json:"quotes"`
+}
+
+func (o *OHLCVHistoricalOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if len(o.ID) > 0 {
+		q.Set("id", joinInts(o.ID))
+	}
+	if len(o.Symbol) > 0 {
+		q.Set("symbol", strings.Join(o.Symbol, ","))
+	}
+	if !o.TimeStart.IsZero() {
+		q.Set("time_start", o.TimeStart.Format(time.RFC3339))
+	}
+	if !o.TimeEnd.IsZero() {
+		q.Set("time_end", o.TimeEnd.Format(time.RFC3339))
+	}
+	if o.Count > 0 {
+		q.Set("count", strconv.Itoa(o.Count))
+	}
+	if o.Interval != "" {
+		q.Set("interval", o.Interval)
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	return q
+}
+
+// OHLCVHistorical returns a historical OHLCV series for the coins in opts.
+func (s *CryptocurrencyService) OHLCVHistorical(ctx context.Context, opts *OHLCVHistoricalOptions) (map[string]*OHLCVHistorical, error) {
+	var out map[string]*OHLCVHistorical
+	err := s.client.get(ctx, "cryptocurrency/ohlcv/historical", opts.query(), &out)
+	return out, err
+}
@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCryptocurrencyInfoRequestAndDecode(t *testing.T) {
+	var gotPath, gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"status":{"error_code":0},"data":{"1":{"id":1,"name":"Bitcoin","symbol":"BTC"}}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	out, err := c.Cryptocurrency.Info(context.Background(), &CryptocurrencyInfoOptions{Symbol: []string{"BTC"}})
+	if err != nil {
+		t.Fatalf("Info() error = %v", err)
+	}
+	if gotPath != "/cryptocurrency/info" {
+		t.Errorf("path = %q, want %q", gotPath, "/cryptocurrency/info")
+	}
+	if gotQuery != "symbol=BTC" {
+		t.Errorf("query = %q, want %q", gotQuery, "symbol=BTC")
+	}
+	if got := out["1"].Name; got != "Bitcoin" {
+		t.Errorf("out[1].Name = %q, want %q", got, "Bitcoin")
+	}
+}
+
+func TestCryptocurrencyInfoNilOptionsDoesNotPanic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("query = %q, want empty for nil opts", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	if _, err := c.Cryptocurrency.Info(context.Background(), nil); err != nil {
+		t.Fatalf("Info(nil) error = %v", err)
+	}
+}
+
+func TestCryptocurrencyLatestQuotesNilOptionsDoesNotPanic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	if _, err := c.Cryptocurrency.LatestQuotes(context.Background(), nil); err != nil {
+		t.Fatalf("LatestQuotes(nil) error = %v", err)
+	}
+}
+
+func TestCryptocurrencyMarketPairsLatestRequestAndNilOptions(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"status":{"error_code":0},"data":{"id":1,"symbol":"BTC","num_market_pairs":2}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	out, err := c.Cryptocurrency.MarketPairsLatest(context.Background(), &MarketPairsOptions{Symbol: "BTC", Limit: 10})
+	if err != nil {
+		t.Fatalf("MarketPairsLatest() error = %v", err)
+	}
+	if gotQuery != "limit=10&symbol=BTC" {
+		t.Errorf("query = %q, want %q", gotQuery, "limit=10&symbol=BTC")
+	}
+	if out.NumMarketPairs != 2 {
+		t.Errorf("NumMarketPairs = %d, want 2", out.NumMarketPairs)
+	}
+
+	if _, err := c.Cryptocurrency.MarketPairsLatest(context.Background(), nil); err != nil {
+		t.Fatalf("MarketPairsLatest(nil) error = %v", err)
+	}
+}
+
+func TestCryptocurrencyOHLCVLatestAndHistoricalNilOptions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	if _, err := c.Cryptocurrency.OHLCVLatest(context.Background(), nil); err != nil {
+		t.Fatalf("OHLCVLatest(nil) error = %v", err)
+	}
+	if _, err := c.Cryptocurrency.OHLCVHistorical(context.Background(), nil); err != nil {
+		t.Fatalf("OHLCVHistorical(nil) error = %v", err)
+	}
+	if _, err := c.Cryptocurrency.HistoricalQuotes(context.Background(), nil); err != nil {
+		t.Fatalf("HistoricalQuotes(nil) error = %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+package v1
+
+import "fmt"
+
+// APIError represents an error surfaced by the Pro API's status envelope,
+// i.e. a non-zero status.error_code.
+type APIError struct {
+	Code    int
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("cmc/pro: error %d: %s", e.Code, e.Message)
+}
@@ -0,0 +1,279 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExchangeService groups the /v1/exchange/* endpoints.
+type ExchangeService struct {
+	client *Client
+}
+
+// ExchangeInfoOptions selects which exchanges Info describes. At least one
+// of ID or Slug must be set.
+type ExchangeInfoOptions struct {
+	ID   []int
+	Slug []string
+}
+
+// ExchangeInfo is static metadata about an exchange.
+type ExchangeInfo struct {
+	ID           int                 `json:"id"`
+	Name         string              `json:"name"`
+	Slug         string              `json:"slug"`
+	Logo         string              `json:"logo"`
+	Description  string              `json:"description"`
+	DateLaunched time.Time           `json:"date_launched"`
+	Urls         map[string][]string `json:"urls"`
+}
+
+func (o *ExchangeInfoOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if len(o.ID) > 0 {
+		q.Set("id", joinInts(o.ID))
+	}
+	if len(o.Slug) > 0 {
+		q.Set("slug", strings.Join(o.Slug, ","))
+	}
+	return q
+}
+
+// Info returns metadata for the exchanges identified by opts.
+func (s *ExchangeService) Info(ctx context.Context, opts *ExchangeInfoOptions) (map[string]*ExchangeInfo, error) {
+	var out map[string]*ExchangeInfo
+	err := s.client.get(ctx, "exchange/info", opts.query(), &out)
+	return out, err
+}
+
+// ExchangeMapOptions filters the exchange map.
+type ExchangeMapOptions struct {
+	ListingStatus string
+	Start         int
+	Limit         int
+	Slug          []string
+}
+
+// ExchangeMapItem is one entry of the id<->slug exchange map.
+type ExchangeMapItem struct {
+	ID                  int       `json:"id"`
+	Name                string    `json:"name"`
+	Slug                string    `json:"slug"`
+	IsActive            int       `json:"is_active"`
+	FirstHistoricalData time.Time `json:"first_historical_data"`
+	LastHistoricalData  time.Time `json:"last_historical_data"`
+}
+
+// Map returns the full CoinMarketCap exchange id<->slug map, optionally
+// filtered by opts.
+func (s *ExchangeService) Map(ctx context.Context, opts *ExchangeMapOptions) ([]*ExchangeMapItem, error) {
+	q := url.Values{}
+	if opts != nil {
+		if opts.ListingStatus != "" {
+			q.Set("listing_status", opts.ListingStatus)
+		}
+		if opts.Start > 0 {
+			q.Set("start", strconv.Itoa(opts.Start))
+		}
+		if opts.Limit > 0 {
+			q.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if len(opts.Slug) > 0 {
+			q.Set("slug", strings.Join(opts.Slug, ","))
+		}
+	}
+
+	var out []*ExchangeMapItem
+	err := s.client.get(ctx, "exchange/map", q, &out)
+	return out, err
+}
+
+// ExchangeListingsOptions controls LatestListings.
+type ExchangeListingsOptions struct {
+	Start   int
+	Limit   int
+	Convert string
+	Sort    string
+}
+
+// ExchangeListing is one ranked entry returned by LatestListings.
+type ExchangeListing struct {
+	ID     int              `json:"id"`
+	Name   string           `json:"name"`
+	Slug   string           `json:"slug"`
+	Quotes map[string]Quote `json:"quote"`
+}
+
+// LatestListings returns exchanges ranked by the latest market data.
+func (s *ExchangeService) LatestListings(ctx context.Context, opts *ExchangeListingsOptions) ([]*ExchangeListing, error) {
+	q := url.Values{}
+	if opts != nil {
+		if opts.Start > 0 {
+			q.Set("start", strconv.Itoa(opts.Start))
+		}
+		if opts.Limit > 0 {
+			q.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Convert != "" {
+			q.Set("convert", opts.Convert)
+		}
+		if opts.Sort != "" {
+			q.Set("sort", opts.Sort)
+		}
+	}
+
+	var out []*ExchangeListing
+	err := s.client.get(ctx, "exchange/listings/latest", q, &out)
+	return out, err
+}
+
+// ExchangeQuotesOptions selects exchanges by ID or Slug for the quotes
+// endpoints. Exactly one of ID or Slug should be set.
+type ExchangeQuotesOptions struct {
+	ID      []int
+	Slug    []string
+	Convert string
+}
+
+// ExchangeQuote is an exchange and its market data in one or more
+// currencies.
+type ExchangeQuote struct {
+	ID     int              `json:"id"`
+	Name   string           `json:"name"`
+	Slug   string           `json:"slug"`
+	Quotes map[string]Quote `json:"quote"`
+}
+
+func (o *ExchangeQuotesOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if len(o.ID) > 0 {
+		q.Set("id", joinInts(o.ID))
+	}
+	if len(o.Slug) > 0 {
+		q.Set("slug", strings.Join(o.Slug, ","))
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	return q
+}
+
+// LatestQuotes returns the latest market data for the exchanges in opts.
+func (s *ExchangeService) LatestQuotes(ctx context.Context, opts *ExchangeQuotesOptions) (map[string]*ExchangeQuote, error) {
+	var out map[string]*ExchangeQuote
+	err := s.client.get(ctx, "exchange/quotes/latest", opts.query(), &out)
+	return out, err
+}
+
+// ExchangeHistoricalQuotesOptions controls HistoricalQuotes.
+type ExchangeHistoricalQuotesOptions struct {
+	ID        []int
+	Slug      []string
+	TimeStart time.Time
+	TimeEnd   time.Time
+	Count     int
+	Interval  string
+	Convert   string
+}
+
+// ExchangeHistoricalQuote is an exchange's historical quote series.
+type ExchangeHistoricalQuote struct {
+	ID     int                    `json:"id"`
+	Name   string                 `json:"name"`
+	Slug   string                 `json:"slug"`
+	Quotes []HistoricalQuotePoint `json:"quotes"`
+}
+
+func (o *ExchangeHistoricalQuotesOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if len(o.ID) > 0 {
+		q.Set("id", joinInts(o.ID))
+	}
+	if len(o.Slug) > 0 {
+		q.Set("slug", strings.Join(o.Slug, ","))
+	}
+	if !o.TimeStart.IsZero() {
+		q.Set("time_start", o.TimeStart.Format(time.RFC3339))
+	}
+	if !o.TimeEnd.IsZero() {
+		q.Set("time_end", o.TimeEnd.Format(time.RFC3339))
+	}
+	if o.Count > 0 {
+		q.Set("count", strconv.Itoa(o.Count))
+	}
+	if o.Interval != "" {
+		q.Set("interval", o.Interval)
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	return q
+}
+
+// HistoricalQuotes returns interval-spaced historical market data for the
+// exchanges in opts.
+func (s *ExchangeService) HistoricalQuotes(ctx context.Context, opts *ExchangeHistoricalQuotesOptions) (map[string]*ExchangeHistoricalQuote, error) {
+	var out map[string]*ExchangeHistoricalQuote
+	err := s.client.get(ctx, "exchange/quotes/historical", opts.query(), &out)
+	return out, err
+}
+
+// ExchangeMarketPairsOptions controls MarketPairsLatest.
+type ExchangeMarketPairsOptions struct {
+	ID      int
+	Slug    string
+	Start   int
+	Limit   int
+	Convert string
+}
+
+// ExchangeMarketPairsLatest is the response shape for MarketPairsLatest.
+type ExchangeMarketPairsLatest struct {
+	ID             int          `json:"id"`
+	Name           string       `json:"name"`
+	Slug           string       `json:"slug"`
+	NumMarketPairs int          `json:"num_market_pairs"`
+	MarketPairs    []MarketPair `json:"market_pairs"`
+}
+
+func (o *ExchangeMarketPairsOptions) query() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if o.ID != 0 {
+		q.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.Slug != "" {
+		q.Set("slug", o.Slug)
+	}
+	if o.Start > 0 {
+		q.Set("start", strconv.Itoa(o.Start))
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Convert != "" {
+		q.Set("convert", o.Convert)
+	}
+	return q
+}
+
+// MarketPairsLatest returns the latest market pairs listed on an exchange.
+func (s *ExchangeService) MarketPairsLatest(ctx context.Context, opts *ExchangeMarketPairsOptions) (*ExchangeMarketPairsLatest, error) {
+	var out *ExchangeMarketPairsLatest
+	err := s.client.get(ctx, "exchange/market-pairs/latest", opts.query(), &out)
+	return out, err
+}
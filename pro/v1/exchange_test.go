@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExchangeInfoRequestAndDecode(t *testing.T) {
+	var gotPath, gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"status":{"error_code":0},"data":{"270":{"id":270,"name":"Binance","slug":"binance"}}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	out, err := c.Exchange.Info(context.Background(), &ExchangeInfoOptions{Slug: []string{"binance"}})
+	if err != nil {
+		t.Fatalf("Info() error = %v", err)
+	}
+	if gotPath != "/exchange/info" {
+		t.Errorf("path = %q, want %q", gotPath, "/exchange/info")
+	}
+	if gotQuery != "slug=binance" {
+		t.Errorf("query = %q, want %q", gotQuery, "slug=binance")
+	}
+	if got := out["270"].Name; got != "Binance" {
+		t.Errorf("out[270].Name = %q, want %q", got, "Binance")
+	}
+}
+
+func TestExchangeInfoNilOptionsDoesNotPanic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("query = %q, want empty for nil opts", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	if _, err := c.Exchange.Info(context.Background(), nil); err != nil {
+		t.Fatalf("Info(nil) error = %v", err)
+	}
+}
+
+func TestExchangeLatestQuotesNilOptionsDoesNotPanic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	if _, err := c.Exchange.LatestQuotes(context.Background(), nil); err != nil {
+		t.Fatalf("LatestQuotes(nil) error = %v", err)
+	}
+}
+
+func TestExchangeMarketPairsLatestRequestAndNilOptions(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"status":{"error_code":0},"data":{"id":270,"slug":"binance","num_market_pairs":3}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	out, err := c.Exchange.MarketPairsLatest(context.Background(), &ExchangeMarketPairsOptions{Slug: "binance", Limit: 5})
+	if err != nil {
+		t.Fatalf("MarketPairsLatest() error = %v", err)
+	}
+	if gotQuery != "limit=5&slug=binance" {
+		t.Errorf("query = %q, want %q", gotQuery, "limit=5&slug=binance")
+	}
+	if out.NumMarketPairs != 3 {
+		t.Errorf("NumMarketPairs = %d, want 3", out.NumMarketPairs)
+	}
+
+	if _, err := c.Exchange.MarketPairsLatest(context.Background(), nil); err != nil {
+		t.Fatalf("MarketPairsLatest(nil) error = %v", err)
+	}
+}
+
+func TestExchangeHistoricalQuotesNilOptionsDoesNotPanic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	if _, err := c.Exchange.HistoricalQuotes(context.Background(), nil); err != nil {
+		t.Fatalf("HistoricalQuotes(nil) error = %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// GlobalMetricsService groups the /v1/global-metrics/* endpoints.
+type GlobalMetricsService struct {
+	client *Client
+}
+
+// GlobalQuote is the global cryptocurrency market's aggregate data in one or
+// more currencies.
+type GlobalQuote struct {
+	ActiveCryptocurrencies int              `json:"active_cryptocurrencies"`
+	ActiveExchanges        int              `json:"active_exchanges"`
+	BTCDominance           float64          `json:"btc_dominance"`
+	ETHDominance           float64          `json:"eth_dominance"`
+	Quotes                 map[string]Quote `json:"quote"`
+	LastUpdated            time.Time        `json:"last_updated"`
+}
+
+// LatestQuotes returns the latest global market metrics converted into
+// convert (defaults to USD if empty).
+func (s *GlobalMetricsService) LatestQuotes(ctx context.Context, convert string) (*GlobalQuote, error) {
+	q := url.Values{}
+	if convert != "" {
+		q.Set("convert", convert)
+	}
+
+	var out *GlobalQuote
+	err := s.client.get(ctx, "global-metrics/quotes/latest", q, &out)
+	return out, err
+}
+
+// GlobalHistoricalQuotesOptions controls HistoricalQuotes.
+type GlobalHistoricalQuotesOptions struct {
+	TimeStart time.Time
+	TimeEnd   time.Time
+	Count     int
+	Interval  string
+	Convert   string
+}
+
+// HistoricalQuotes returns interval-spaced historical global market metrics.
+func (s *GlobalMetricsService) HistoricalQuotes(ctx context.Context, opts *GlobalHistoricalQuotesOptions) ([]*GlobalQuote, error) {
+	q := url.Values{}
+	if opts != nil {
+		if !opts.TimeStart.IsZero() {
+			q.Set("time_start", opts.TimeStart.Format(time.RFC3339))
+		}
+		if !opts.TimeEnd.IsZero() {
+			q.Set("time_end", opts.TimeEnd.Format(time.RFC3339))
+		}
+		if opts.Count > 0 {
+			q.Set("count", strconv.Itoa(opts.Count))
+		}
+		if opts.Interval != "" {
+			q.Set("interval", opts.Interval)
+		}
+		if opts.Convert != "" {
+			q.Set("convert", opts.Convert)
+		}
+	}
+
+	var out []*GlobalQuote
+	err := s.client.get(ctx, "global-metrics/quotes/historical", q, &out)
+	return out, err
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGlobalMetricsLatestQuotesRequestAndDecode(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"status":{"error_code":0},"data":{"btc_dominance":48.5}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	out, err := c.GlobalMetrics.LatestQuotes(context.Background(), "EUR")
+	if err != nil {
+		t.Fatalf("LatestQuotes() error = %v", err)
+	}
+	if gotQuery != "convert=EUR" {
+		t.Errorf("query = %q, want %q", gotQuery, "convert=EUR")
+	}
+	if out.BTCDominance != 48.5 {
+		t.Errorf("BTCDominance = %v, want 48.5", out.BTCDominance)
+	}
+}
+
+func TestGlobalMetricsHistoricalQuotesNilOptionsDoesNotPanic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("query = %q, want empty for nil opts", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"status":{"error_code":0},"data":[]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	if _, err := c.GlobalMetrics.HistoricalQuotes(context.Background(), nil); err != nil {
+		t.Fatalf("HistoricalQuotes(nil) error = %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ToolsService groups the /v1/tools/* endpoints.
+type ToolsService struct {
+	client *Client
+}
+
+// PriceConversionOptions controls PriceConversion. Exactly one of ID or
+// Symbol should be set to identify the source currency.
+type PriceConversionOptions struct {
+	Amount  float64
+	ID      int
+	Symbol  string
+	Time    time.Time
+	Convert []string
+}
+
+// PriceConversion is the result of converting Amount of one currency into
+// each of the requested Convert currencies.
+type PriceConversion struct {
+	Symbol      string           `json:"symbol"`
+	ID          int              `json:"id"`
+	Amount      float64          `json:"amount"`
+	LastUpdated time.Time        `json:"last_updated"`
+	Quotes      map[string]Quote `json:"quote"`
+}
+
+// PriceConversion converts an amount of one cryptocurrency or fiat currency
+// into others.
+func (s *ToolsService) PriceConversion(ctx context.Context, opts *PriceConversionOptions) (*PriceConversion, error) {
+	if opts == nil {
+		opts = &PriceConversionOptions{}
+	}
+	q := url.Values{}
+	q.Set("amount", strconv.FormatFloat(opts.Amount, 'f', -1, 64))
+	if opts.ID != 0 {
+		q.Set("id", strconv.Itoa(opts.ID))
+	}
+	if opts.Symbol != "" {
+		q.Set("symbol", opts.Symbol)
+	}
+	if !opts.Time.IsZero() {
+		q.Set("time", opts.Time.Format(time.RFC3339))
+	}
+	if len(opts.Convert) > 0 {
+		q.Set("convert", strings.Join(opts.Convert, ","))
+	}
+
+	var out *PriceConversion
+	err := s.client.get(ctx, "tools/price-conversion", q, &out)
+	return out, err
+}
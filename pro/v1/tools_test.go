@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPriceConversionRequestAndDecode(t *testing.T) {
+	var gotQuery string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"status":{"error_code":0},"data":{"symbol":"BTC","amount":1.5}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	out, err := c.Tools.PriceConversion(context.Background(), &PriceConversionOptions{Amount: 1.5, Symbol: "BTC"})
+	if err != nil {
+		t.Fatalf("PriceConversion() error = %v", err)
+	}
+	if gotQuery != "amount=1.5&symbol=BTC" {
+		t.Errorf("query = %q, want %q", gotQuery, "amount=1.5&symbol=BTC")
+	}
+	if out.Amount != 1.5 {
+		t.Errorf("Amount = %v, want 1.5", out.Amount)
+	}
+}
+
+func TestPriceConversionNilOptionsDoesNotPanic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "amount=0" {
+			t.Errorf("query = %q, want %q for nil opts", r.URL.RawQuery, "amount=0")
+		}
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{ProAPIKey: "k", HTTPClient: srv.Client()})
+	c.baseURL = srv.URL + "/"
+
+	if _, err := c.Tools.PriceConversion(context.Background(), nil); err != nil {
+		t.Fatalf("PriceConversion(nil) error = %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// envelope is the standard {status, data} wrapper returned by every Pro API
+// endpoint.
+type envelope struct {
+	Status status          `json:"status"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// status is the status block of envelope.
+type status struct {
+	Timestamp    string `json:"timestamp"`
+	ErrorCode    int    `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+	Elapsed      int    `json:"elapsed"`
+	CreditCount  int    `json:"credit_count"`
+}
+
+// Quote holds market data for a cryptocurrency, exchange or the global
+// market, converted into one fiat/crypto currency.
+type Quote struct {
+	Price            float64   `json:"price"`
+	Volume24h        float64   `json:"volume_24h"`
+	PercentChange1h  float64   `json:"percent_change_1h"`
+	PercentChange24h float64   `json:"percent_change_24h"`
+	PercentChange7d  float64   `json:"percent_change_7d"`
+	MarketCap        float64   `json:"market_cap"`
+	LastUpdated      time.Time `json:"last_updated"`
+}
+
+// OHLCV holds open/high/low/close/volume data for a single interval.
+type OHLCV struct {
+	Open        float64   `json:"open"`
+	High        float64   `json:"high"`
+	Low         float64   `json:"low"`
+	Close       float64   `json:"close"`
+	Volume      float64   `json:"volume"`
+	MarketCap   float64   `json:"market_cap"`
+	Timestamp   time.Time `json:"timestamp"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// joinInts renders ids as a comma-separated list, the form every Pro
+// endpoint expects for list-valued parameters such as id.
+func joinInts(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ",")
+}
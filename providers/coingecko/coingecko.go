@@ -0,0 +1,416 @@
+// Package coingecko is a providers.Provider backed by the public CoinGecko
+// API (https://api.coingecko.com/api/v3), useful as a fallback when
+// CoinMarketCap rate-limits or requires a paid key.
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hhh0pE/go-coinmarketcap/providers"
+	"github.com/hhh0pE/go-coinmarketcap/v2/types"
+)
+
+var baseURL = "https://api.coingecko.com/api/v3"
+
+// Provider is a providers.Provider implementation backed by CoinGecko.
+type Provider struct {
+	httpClient *http.Client
+}
+
+// NewProvider returns a CoinGecko-backed Provider using http.DefaultClient.
+func NewProvider() *Provider {
+	return &Provider{httpClient: http.DefaultClient}
+}
+
+var _ providers.Provider = (*Provider)(nil)
+
+// Ping checks that the CoinGecko API is reachable.
+func (p *Provider) Ping() error {
+	_, err := p.get("/ping")
+	return err
+}
+
+func (p *Provider) get(path string) ([]byte, error) {
+	resp, err := p.httpClient.Get(baseURL + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("coingecko: %s: %s", resp.Status, body)
+	}
+	return body, nil
+}
+
+// coinID resolves a ticker symbol to the string ID CoinGecko expects
+// (e.g. "BTC" -> "bitcoin") by scanning the full coin list.
+func (p *Provider) coinID(symbol string) (string, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	listings, err := p.Listings()
+	if err != nil {
+		return "", err
+	}
+	for _, l := range listings {
+		if l.Symbol == symbol {
+			return l.WebsiteSlug, nil
+		}
+	}
+	return "", fmt.Errorf("coingecko: coin not found: %s", symbol)
+}
+
+// coinListEntry is one entry of the /coins/list response.
+type coinListEntry struct {
+	ID     string `json:"id"`
+	Symbol string `json:"symbol"`
+	Name   string `json:"name"`
+}
+
+// Listings implements providers.Provider. CoinGecko identifies coins by
+// string slug rather than CMC's numeric ID, so ID is left unset and the
+// slug is carried in WebsiteSlug.
+func (p *Provider) Listings() ([]*types.Listing, error) {
+	body, err := p.get("/coins/list")
+	if err != nil {
+		return nil, err
+	}
+	var entries []coinListEntry
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, err
+	}
+	listings := make([]*types.Listing, 0, len(entries))
+	for _, e := range entries {
+		listings = append(listings, &types.Listing{
+			Name:        e.Name,
+			Symbol:      strings.ToUpper(e.Symbol),
+			WebsiteSlug: e.ID,
+		})
+	}
+	return listings, nil
+}
+
+// marketsEntry is one entry of the /coins/markets response.
+type marketsEntry struct {
+	ID                    string  `json:"id"`
+	Symbol                string  `json:"symbol"`
+	Name                  string  `json:"name"`
+	CurrentPrice          float64 `json:"current_price"`
+	MarketCap             float64 `json:"market_cap"`
+	MarketCapRank         int     `json:"market_cap_rank"`
+	TotalVolume           float64 `json:"total_volume"`
+	CirculatingSupply     float64 `json:"circulating_supply"`
+	TotalSupply           float64 `json:"total_supply"`
+	MaxSupply             float64 `json:"max_supply"`
+	PriceChangePercent1h  float64 `json:"price_change_percentage_1h_in_currency"`
+	PriceChangePercent24h float64 `json:"price_change_percentage_24h_in_currency"`
+	PriceChangePercent7d  float64 `json:"price_change_percentage_7d_in_currency"`
+	LastUpdated           string  `json:"last_updated"`
+}
+
+func (e *marketsEntry) toTicker(convert string) *types.Ticker {
+	quote := types.Quote{
+		Price:            e.CurrentPrice,
+		Volume24h:        e.TotalVolume,
+		MarketCap:        e.MarketCap,
+		PercentChange1h:  e.PriceChangePercent1h,
+		PercentChange24h: e.PriceChangePercent24h,
+		PercentChange7d:  e.PriceChangePercent7d,
+	}
+	lastUpdated, _ := time.Parse(time.RFC3339, e.LastUpdated)
+	return &types.Ticker{
+		Name:              e.Name,
+		Symbol:            strings.ToUpper(e.Symbol),
+		Slug:              e.ID,
+		Rank:              e.MarketCapRank,
+		CirculatingSupply: e.CirculatingSupply,
+		TotalSupply:       e.TotalSupply,
+		MaxSupply:         e.MaxSupply,
+		LastUpdated:       lastUpdated.Unix(),
+		Quotes:            map[string]types.Quote{strings.ToUpper(convert): quote},
+	}
+}
+
+// Tickers implements providers.Provider.
+func (p *Provider) Tickers(options *providers.TickersOptions) ([]*types.Ticker, error) {
+	convert := options.Convert
+	if convert == "" {
+		convert = "USD"
+	}
+	limit := options.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	page := options.Start/limit + 1
+
+	path := fmt.Sprintf("/coins/markets?vs_currency=%s&per_page=%d&page=%d&price_change_percentage=1h,24h,7d",
+		strings.ToLower(convert), limit, page)
+	body, err := p.get(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []marketsEntry
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, err
+	}
+	tickers := make([]*types.Ticker, 0, len(entries))
+	for _, e := range entries {
+		tickers = append(tickers, e.toTicker(convert))
+	}
+	return tickers, nil
+}
+
+// Ticker implements providers.Provider.
+func (p *Provider) Ticker(options *providers.TickerOptions) (*types.Ticker, error) {
+	id, err := p.coinID(options.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	convert := options.Convert
+	if convert == "" {
+		convert = "USD"
+	}
+
+	body, err := p.get(fmt.Sprintf("/coins/%s?localization=false&tickers=false&community_data=false&developer_data=false", id))
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		ID            string `json:"id"`
+		Symbol        string `json:"symbol"`
+		Name          string `json:"name"`
+		MarketCapRank int    `json:"market_cap_rank"`
+		MarketData    struct {
+			CurrentPrice             map[string]float64 `json:"current_price"`
+			MarketCap                map[string]float64 `json:"market_cap"`
+			TotalVolume              map[string]float64 `json:"total_volume"`
+			CirculatingSupply        float64            `json:"circulating_supply"`
+			TotalSupply              float64            `json:"total_supply"`
+			MaxSupply                float64            `json:"max_supply"`
+			PriceChangePercentage1h  float64            `json:"price_change_percentage_1h_in_currency"`
+			PriceChangePercentage24h float64            `json:"price_change_percentage_24h"`
+			PriceChangePercentage7d  float64            `json:"price_change_percentage_7d"`
+			LastUpdated              string             `json:"last_updated"`
+		} `json:"market_data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	currency := strings.ToLower(convert)
+	lastUpdated, _ := time.Parse(time.RFC3339, resp.MarketData.LastUpdated)
+	return &types.Ticker{
+		Name:              resp.Name,
+		Symbol:            strings.ToUpper(resp.Symbol),
+		Slug:              resp.ID,
+		Rank:              resp.MarketCapRank,
+		CirculatingSupply: resp.MarketData.CirculatingSupply,
+		TotalSupply:       resp.MarketData.TotalSupply,
+		MaxSupply:         resp.MarketData.MaxSupply,
+		LastUpdated:       lastUpdated.Unix(),
+		Quotes: map[string]types.Quote{
+			strings.ToUpper(convert): {
+				Price:            resp.MarketData.CurrentPrice[currency],
+				Volume24h:        resp.MarketData.TotalVolume[currency],
+				MarketCap:        resp.MarketData.MarketCap[currency],
+				PercentChange1h:  resp.MarketData.PriceChangePercentage1h,
+				PercentChange24h: resp.MarketData.PriceChangePercentage24h,
+				PercentChange7d:  resp.MarketData.PriceChangePercentage7d,
+			},
+		},
+	}, nil
+}
+
+// TickerGraph implements providers.Provider.
+func (p *Provider) TickerGraph(options *providers.TickerGraphOptions) (*types.TickerGraph, error) {
+	id, err := p.coinID(options.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	days := (options.End - options.Start) / 86400
+	if days < 1 {
+		days = 1
+	}
+
+	body, err := p.get(fmt.Sprintf("/coins/%s/market_chart?vs_currency=usd&days=%d", id, days))
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Prices       [][]float64 `json:"prices"`
+		MarketCaps   [][]float64 `json:"market_caps"`
+		TotalVolumes [][]float64 `json:"total_volumes"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	return &types.TickerGraph{
+		PriceUSD:                   resp.Prices,
+		MarketCapByAvailableSupply: resp.MarketCaps,
+		Volume:                     resp.TotalVolumes,
+	}, nil
+}
+
+// GlobalMarket implements providers.Provider.
+func (p *Provider) GlobalMarket(options *providers.GlobalMarketOptions) (*types.GlobalMarket, error) {
+	convert := options.Convert
+	if convert == "" {
+		convert = "usd"
+	}
+	convert = strings.ToLower(convert)
+
+	body, err := p.get("/global")
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Data struct {
+			ActiveCryptocurrencies int                `json:"active_cryptocurrencies"`
+			Markets                int                `json:"markets"`
+			TotalMarketCap         map[string]float64 `json:"total_market_cap"`
+			TotalVolume            map[string]float64 `json:"total_volume"`
+			MarketCapPercentage    map[string]float64 `json:"market_cap_percentage"`
+			UpdatedAt              int64              `json:"updated_at"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	return &types.GlobalMarket{
+		TotalMarketCap:            resp.Data.TotalMarketCap[convert],
+		Total24hVolume:            resp.Data.TotalVolume[convert],
+		BitcoinPercentageOfMarket: resp.Data.MarketCapPercentage["btc"],
+		ActiveCurrencies:          resp.Data.ActiveCryptocurrencies,
+		ActiveMarkets:             resp.Data.Markets,
+		LastUpdated:               resp.Data.UpdatedAt,
+	}, nil
+}
+
+// Markets implements providers.Provider, backed by CoinGecko's per-coin
+// tickers endpoint.
+func (p *Provider) Markets(options *providers.MarketsOptions) ([]*types.Market, error) {
+	id := options.Slug
+	if id == "" {
+		resolved, err := p.coinID(options.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		id = resolved
+	}
+
+	body, err := p.get(fmt.Sprintf("/coins/%s/tickers", id))
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Tickers []struct {
+			Market struct {
+				Name       string `json:"name"`
+				Identifier string `json:"identifier"`
+			} `json:"market"`
+			Base        string  `json:"base"`
+			Target      string  `json:"target"`
+			Volume      float64 `json:"volume"`
+			Last        float64 `json:"last"`
+			LastFetchAt string  `json:"last_fetch_at"`
+		} `json:"tickers"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	markets := make([]*types.Market, 0, len(resp.Tickers))
+	for i, t := range resp.Tickers {
+		markets = append(markets, &types.Market{
+			Rank:         i + 1,
+			Exchange:     t.Market.Name,
+			ExchangeSlug: t.Market.Identifier,
+			Pair:         fmt.Sprintf("%s/%s", t.Base, t.Target),
+			VolumeUSD:    t.Volume,
+			Price:        t.Last,
+			Updated:      t.LastFetchAt,
+		})
+	}
+	return markets, nil
+}
+
+// Price implements providers.Provider.
+func (p *Provider) Price(options *providers.PriceOptions) (float64, error) {
+	id, err := p.coinID(options.Symbol)
+	if err != nil {
+		return 0, err
+	}
+	convert := options.Convert
+	if convert == "" {
+		convert = "USD"
+	}
+	currency := strings.ToLower(convert)
+
+	body, err := p.get(fmt.Sprintf("/simple/price?ids=%s&vs_currencies=%s", id, currency))
+	if err != nil {
+		return 0, err
+	}
+	var resp map[string]map[string]float64
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return 0, err
+	}
+	coin, ok := resp[id]
+	if !ok {
+		return 0, fmt.Errorf("coingecko: coin not found: %s", options.Symbol)
+	}
+	return coin[currency], nil
+}
+
+// exchangeEntry is one entry of the /exchanges response.
+type exchangeEntry struct {
+	ID                string  `json:"id"`
+	Name              string  `json:"name"`
+	YearEstablished   int     `json:"year_established"`
+	Image             string  `json:"image"`
+	TrustScoreRank    int     `json:"trust_score_rank"`
+	TradeVolume24hBTC float64 `json:"trade_volume_24h_btc"`
+}
+
+// Exchanges implements providers.Provider, backed by CoinGecko's /exchanges
+// endpoint. CoinGecko reports volume in BTC rather than USD and doesn't
+// expose 7d/30d rollups or a market count, so Volume24h carries the raw BTC
+// figure and Volume7d/Volume30d/MarketsNumber/Change24h are left unset.
+func (p *Provider) Exchanges() ([]*types.Exchange, error) {
+	body, err := p.get("/exchanges?per_page=250")
+	if err != nil {
+		return nil, err
+	}
+	var entries []exchangeEntry
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, err
+	}
+	exchanges := make([]*types.Exchange, 0, len(entries))
+	for i, e := range entries {
+		var launchedAt time.Time
+		if e.YearEstablished > 0 {
+			launchedAt = time.Date(e.YearEstablished, time.January, 1, 0, 0, 0, 0, time.UTC)
+		}
+		rank := e.TrustScoreRank
+		if rank == 0 {
+			rank = i + 1
+		}
+		exchanges = append(exchanges, &types.Exchange{
+			Rank:       rank,
+			Name:       e.Name,
+			Slug:       e.ID,
+			LogoImg:    e.Image,
+			Volume24h:  e.TradeVolume24hBTC,
+			LaunchedAt: launchedAt,
+		})
+	}
+	return exchanges, nil
+}
@@ -0,0 +1,128 @@
+package providers
+
+import (
+	"fmt"
+
+	"github.com/hhh0pE/go-coinmarketcap/v2/types"
+)
+
+// Multi tries its wrapped Providers in order and returns the first
+// non-error result, so callers stay up when one backend rate-limits or
+// requires a paid key.
+type Multi struct {
+	Providers []Provider
+}
+
+// NewMulti builds a Multi provider from providers, tried in order on every
+// call: providers[0] first, falling through to the next only on error.
+func NewMulti(providers ...Provider) *Multi {
+	return &Multi{Providers: providers}
+}
+
+func (m *Multi) errAllFailed(errs []error) error {
+	return fmt.Errorf("providers: all %d providers failed: %v", len(m.Providers), errs)
+}
+
+// Tickers implements Provider.
+func (m *Multi) Tickers(options *TickersOptions) ([]*types.Ticker, error) {
+	var errs []error
+	for _, p := range m.Providers {
+		tickers, err := p.Tickers(options)
+		if err == nil {
+			return tickers, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, m.errAllFailed(errs)
+}
+
+// Ticker implements Provider.
+func (m *Multi) Ticker(options *TickerOptions) (*types.Ticker, error) {
+	var errs []error
+	for _, p := range m.Providers {
+		ticker, err := p.Ticker(options)
+		if err == nil {
+			return ticker, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, m.errAllFailed(errs)
+}
+
+// TickerGraph implements Provider.
+func (m *Multi) TickerGraph(options *TickerGraphOptions) (*types.TickerGraph, error) {
+	var errs []error
+	for _, p := range m.Providers {
+		graph, err := p.TickerGraph(options)
+		if err == nil {
+			return graph, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, m.errAllFailed(errs)
+}
+
+// GlobalMarket implements Provider.
+func (m *Multi) GlobalMarket(options *GlobalMarketOptions) (*types.GlobalMarket, error) {
+	var errs []error
+	for _, p := range m.Providers {
+		market, err := p.GlobalMarket(options)
+		if err == nil {
+			return market, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, m.errAllFailed(errs)
+}
+
+// Markets implements Provider.
+func (m *Multi) Markets(options *MarketsOptions) ([]*types.Market, error) {
+	var errs []error
+	for _, p := range m.Providers {
+		markets, err := p.Markets(options)
+		if err == nil {
+			return markets, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, m.errAllFailed(errs)
+}
+
+// Price implements Provider.
+func (m *Multi) Price(options *PriceOptions) (float64, error) {
+	var errs []error
+	for _, p := range m.Providers {
+		price, err := p.Price(options)
+		if err == nil {
+			return price, nil
+		}
+		errs = append(errs, err)
+	}
+	return 0, m.errAllFailed(errs)
+}
+
+// Listings implements Provider.
+func (m *Multi) Listings() ([]*types.Listing, error) {
+	var errs []error
+	for _, p := range m.Providers {
+		listings, err := p.Listings()
+		if err == nil {
+			return listings, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, m.errAllFailed(errs)
+}
+
+// Exchanges implements Provider.
+func (m *Multi) Exchanges() ([]*types.Exchange, error) {
+	var errs []error
+	for _, p := range m.Providers {
+		exchanges, err := p.Exchanges()
+		if err == nil {
+			return exchanges, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, m.errAllFailed(errs)
+}
@@ -0,0 +1,88 @@
+package providers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hhh0pE/go-coinmarketcap/v2/types"
+)
+
+// fakeProvider is a minimal Provider whose Tickers call is scripted and
+// counted, for asserting Multi's fallback order.
+type fakeProvider struct {
+	calls   int
+	tickers []*types.Ticker
+	err     error
+}
+
+func (f *fakeProvider) Tickers(options *TickersOptions) ([]*types.Ticker, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.tickers, nil
+}
+
+func (f *fakeProvider) Ticker(options *TickerOptions) (*types.Ticker, error) { return nil, f.err }
+func (f *fakeProvider) TickerGraph(options *TickerGraphOptions) (*types.TickerGraph, error) {
+	return nil, f.err
+}
+func (f *fakeProvider) GlobalMarket(options *GlobalMarketOptions) (*types.GlobalMarket, error) {
+	return nil, f.err
+}
+func (f *fakeProvider) Markets(options *MarketsOptions) ([]*types.Market, error) { return nil, f.err }
+func (f *fakeProvider) Price(options *PriceOptions) (float64, error)             { return 0, f.err }
+func (f *fakeProvider) Listings() ([]*types.Listing, error)                      { return nil, f.err }
+func (f *fakeProvider) Exchanges() ([]*types.Exchange, error)                    { return nil, f.err }
+
+func TestMultiTickersFallsBackOnlyAfterFirstProviderErrors(t *testing.T) {
+	first := &fakeProvider{err: errors.New("rate limited")}
+	second := &fakeProvider{tickers: []*types.Ticker{{Symbol: "BTC"}}}
+	m := NewMulti(first, second)
+
+	tickers, err := m.Tickers(&TickersOptions{})
+	if err != nil {
+		t.Fatalf("Tickers() error = %v", err)
+	}
+	if len(tickers) != 1 || tickers[0].Symbol != "BTC" {
+		t.Fatalf("Tickers() = %+v, want a single BTC ticker from the second provider", tickers)
+	}
+	if first.calls != 1 {
+		t.Errorf("first.calls = %d, want 1", first.calls)
+	}
+	if second.calls != 1 {
+		t.Errorf("second.calls = %d, want 1", second.calls)
+	}
+}
+
+func TestMultiTickersDoesNotCallSecondProviderWhenFirstSucceeds(t *testing.T) {
+	first := &fakeProvider{tickers: []*types.Ticker{{Symbol: "ETH"}}}
+	second := &fakeProvider{tickers: []*types.Ticker{{Symbol: "BTC"}}}
+	m := NewMulti(first, second)
+
+	tickers, err := m.Tickers(&TickersOptions{})
+	if err != nil {
+		t.Fatalf("Tickers() error = %v", err)
+	}
+	if len(tickers) != 1 || tickers[0].Symbol != "ETH" {
+		t.Fatalf("Tickers() = %+v, want the first provider's result", tickers)
+	}
+	if second.calls != 0 {
+		t.Errorf("second.calls = %d, want 0: second provider should not be tried when the first succeeds", second.calls)
+	}
+}
+
+func TestMultiTickersAggregatesErrorsWhenAllProvidersFail(t *testing.T) {
+	first := &fakeProvider{err: errors.New("first down")}
+	second := &fakeProvider{err: errors.New("second down")}
+	m := NewMulti(first, second)
+
+	_, err := m.Tickers(&TickersOptions{})
+	if err == nil {
+		t.Fatal("Tickers() error = nil, want an aggregated error when every provider fails")
+	}
+	if !strings.Contains(err.Error(), "first down") || !strings.Contains(err.Error(), "second down") {
+		t.Errorf("Tickers() error = %q, want it to mention both provider errors", err)
+	}
+}
@@ -0,0 +1,58 @@
+// Package providers defines a backend-agnostic interface for fetching
+// cryptocurrency market data, so callers can swap between CoinMarketCap,
+// CoinGecko or any other backend with one line.
+package providers
+
+import "github.com/hhh0pE/go-coinmarketcap/v2/types"
+
+// TickersOptions options for the Tickers method.
+type TickersOptions struct {
+	Start   int
+	Limit   int
+	Convert string
+	Sort    string
+}
+
+// TickerOptions options for the Ticker method.
+type TickerOptions struct {
+	Symbol  string
+	Convert string
+}
+
+// TickerGraphOptions options for the TickerGraph method.
+type TickerGraphOptions struct {
+	Symbol string
+	Start  int64
+	End    int64
+}
+
+// GlobalMarketOptions options for the GlobalMarket method.
+type GlobalMarketOptions struct {
+	Convert string
+}
+
+// MarketsOptions options for the Markets method.
+type MarketsOptions struct {
+	Symbol string
+	Slug   string
+}
+
+// PriceOptions options for the Price method.
+type PriceOptions struct {
+	Symbol  string
+	Convert string
+}
+
+// Provider is implemented by every market data backend. All methods
+// normalize their responses into the shared types package so callers can
+// swap implementations without touching call sites.
+type Provider interface {
+	Tickers(options *TickersOptions) ([]*types.Ticker, error)
+	Ticker(options *TickerOptions) (*types.Ticker, error)
+	TickerGraph(options *TickerGraphOptions) (*types.TickerGraph, error)
+	GlobalMarket(options *GlobalMarketOptions) (*types.GlobalMarket, error)
+	Markets(options *MarketsOptions) ([]*types.Market, error)
+	Price(options *PriceOptions) (float64, error)
+	Listings() ([]*types.Listing, error)
+	Exchanges() ([]*types.Exchange, error)
+}
@@ -0,0 +1,136 @@
+package coinmarketcap
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hhh0pE/go-coinmarketcap/cache"
+)
+
+// cacheMu guards activeCache and endpointTTLs, both of which are mutated by
+// SetCache/WithTTL and read concurrently, e.g. by the Exchanges
+// worker-pool goroutines (see exchangesByPage).
+var cacheMu sync.RWMutex
+
+// activeCache, when set via SetCache, backs Tickers, Ticker, Listings,
+// GlobalMarket, Markets and Exchanges so long-running callers can survive
+// restarts without re-hammering CMC.
+var activeCache cache.Cache
+
+// endpointTTLs holds the default per-endpoint cache lifetime; override with
+// WithTTL.
+var endpointTTLs = map[string]time.Duration{
+	"tickers":   60 * time.Second,
+	"ticker":    60 * time.Second,
+	"listings":  24 * time.Hour,
+	"global":    60 * time.Second,
+	"markets":   60 * time.Second,
+	"exchanges": 60 * time.Second,
+}
+
+const defaultTTL = 60 * time.Second
+
+// SetCache wires c into subsequent Tickers/Ticker/Listings/GlobalMarket/
+// Markets/Exchanges calls. Pass nil to disable caching.
+func SetCache(c cache.Cache) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	activeCache = c
+}
+
+// WithTTL overrides the cache lifetime for endpoint (one of "tickers",
+// "ticker", "listings", "global", "markets", "exchanges").
+func WithTTL(endpoint string, ttl time.Duration) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	endpointTTLs[endpoint] = ttl
+}
+
+// PurgeCache clears the active cache, if one is set and supports purging.
+func PurgeCache() error {
+	cacheMu.RLock()
+	c := activeCache
+	cacheMu.RUnlock()
+	if p, ok := c.(interface{ Purge() error }); ok {
+		return p.Purge()
+	}
+	return nil
+}
+
+func ttlFor(endpoint string) time.Duration {
+	cacheMu.RLock()
+	defer cacheMu.RUnlock()
+	if ttl, ok := endpointTTLs[endpoint]; ok {
+		return ttl
+	}
+	return defaultTTL
+}
+
+// cachedGet checks the active cache, if any, for key.
+func cachedGet(key string) ([]byte, bool) {
+	cacheMu.RLock()
+	c := activeCache
+	cacheMu.RUnlock()
+	if c == nil {
+		return nil, false
+	}
+	return c.Get(key)
+}
+
+// cacheSet stores body under key for endpoint's configured TTL, if a cache
+// is active.
+func cacheSet(endpoint, key string, body []byte) {
+	cacheMu.RLock()
+	c := activeCache
+	cacheMu.RUnlock()
+	if c == nil {
+		return
+	}
+	c.Set(key, body, ttlFor(endpoint))
+}
+
+// makeCachedReq is makeReq with a cache lookup keyed by url in front of it.
+func makeCachedReq(endpoint, url string) ([]byte, error) {
+	if body, ok := cachedGet(url); ok {
+		return body, nil
+	}
+	body, err := makeReq(url)
+	if err != nil {
+		return nil, err
+	}
+	cacheSet(endpoint, url, body)
+	return body, nil
+}
+
+// fetchCachedPage is a retrying HTTP GET (see doWithRetry) with a cache
+// lookup keyed by pageURL in front of it, used by the Markets/Exchanges
+// scrapers.
+func fetchCachedPage(ctx context.Context, endpoint, pageURL string) ([]byte, error) {
+	if body, ok := cachedGet(pageURL); ok {
+		return body, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := doWithRetry(ctx, http.DefaultClient, req, defaultRetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if 200 != resp.StatusCode {
+		return nil, fmt.Errorf("%s", body)
+	}
+	cacheSet(endpoint, pageURL, body)
+	return body, nil
+}
@@ -0,0 +1,84 @@
+package coinmarketcap
+
+import "strings"
+
+// classifyMarketHeader maps a markets-table header cell to the semantic
+// column it represents, so a row can be parsed by column name rather than
+// position (positions shift whenever CMC tweaks the table markup).
+func classifyMarketHeader(text string) string {
+	t := strings.ToLower(strings.TrimSpace(text))
+	switch {
+	case t == "#" || t == "rank":
+		return "rank"
+	case strings.Contains(t, "exchange"):
+		return "exchange"
+	case strings.Contains(t, "pair"):
+		return "pair"
+	case strings.Contains(t, "%"):
+		return "volumepercent"
+	case strings.Contains(t, "vol"):
+		return "volume"
+	case strings.Contains(t, "price"):
+		return "price"
+	case strings.Contains(t, "categ"):
+		return "category"
+	case strings.Contains(t, "fee"):
+		return "feetype"
+	case strings.Contains(t, "updat"):
+		return "updated"
+	default:
+		return ""
+	}
+}
+
+// classifyExchangeHeader maps an exchange-rankings header cell to the
+// semantic column it represents.
+func classifyExchangeHeader(text string) string {
+	t := strings.ToLower(strings.TrimSpace(text))
+	switch {
+	case t == "#" || t == "rank":
+		return "rank"
+	case strings.Contains(t, "name"):
+		return "name"
+	case strings.Contains(t, "adj"):
+		return "adjvolume24h"
+	case strings.Contains(t, "30d"):
+		return "volume30d"
+	case strings.Contains(t, "7d"):
+		return "volume7d"
+	case strings.Contains(t, "market"):
+		return "markets"
+	case strings.Contains(t, "change"):
+		return "change24h"
+	case strings.Contains(t, "launch"):
+		return "launched"
+	case strings.Contains(t, "vol"):
+		return "volume24h"
+	default:
+		return ""
+	}
+}
+
+// columnIndex maps semantic column names to their position in a row, as
+// built from a table's header cells by classify.
+type columnIndex map[string]int
+
+func buildColumnIndex(headers []string, classify func(string) string) columnIndex {
+	idx := make(columnIndex, len(headers))
+	for i, h := range headers {
+		if key := classify(h); key != "" {
+			idx[key] = i
+		}
+	}
+	return idx
+}
+
+// get returns row[idx[key]], or "" if key wasn't found in the header or the
+// row is shorter than expected.
+func (idx columnIndex) get(row []string, key string) string {
+	i, ok := idx[key]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
@@ -0,0 +1,66 @@
+package coinmarketcap
+
+import "testing"
+
+func TestClassifyMarketHeader(t *testing.T) {
+	cases := map[string]string{
+		"#":            "rank",
+		"Rank":         "rank",
+		"Exchange":     "exchange",
+		"Pair":         "pair",
+		"Volume %":     "volumepercent",
+		"Volume (24h)": "volume",
+		"Price":        "price",
+		"Category":     "category",
+		"Fee Type":     "feetype",
+		"Updated":      "updated",
+		"Last Synced":  "",
+		"":             "",
+	}
+	for header, want := range cases {
+		if got := classifyMarketHeader(header); got != want {
+			t.Errorf("classifyMarketHeader(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestClassifyExchangeHeader(t *testing.T) {
+	cases := map[string]string{
+		"#":                "rank",
+		"Name":             "name",
+		"Adj. Volume(24h)": "adjvolume24h",
+		"Volume(30d)":      "volume30d",
+		"Volume(7d)":       "volume7d",
+		"Volume(24h)":      "volume24h",
+		"# Markets":        "markets",
+		"Change(24h)":      "change24h",
+		"Launched":         "launched",
+		"Unrelated Column": "",
+	}
+	for header, want := range cases {
+		if got := classifyExchangeHeader(header); got != want {
+			t.Errorf("classifyExchangeHeader(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestBuildColumnIndexAndGet(t *testing.T) {
+	headers := []string{"#", "Exchange", "Pair", "Volume(24h)"}
+	idx := buildColumnIndex(headers, classifyMarketHeader)
+
+	row := []string{"1", "Binance", "BTC/USDT", "123456"}
+	if got := idx.get(row, "rank"); got != "1" {
+		t.Errorf("get(rank) = %q, want %q", got, "1")
+	}
+	if got := idx.get(row, "exchange"); got != "Binance" {
+		t.Errorf("get(exchange) = %q, want %q", got, "Binance")
+	}
+	if got := idx.get(row, "price"); got != "" {
+		t.Errorf("get(price) = %q, want empty string for a column not in the header", got)
+	}
+
+	shortRow := []string{"1"}
+	if got := idx.get(shortRow, "exchange"); got != "" {
+		t.Errorf("get(exchange) on a short row = %q, want empty string", got)
+	}
+}
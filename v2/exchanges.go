@@ -0,0 +1,197 @@
+package coinmarketcap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	v1 "github.com/hhh0pE/go-coinmarketcap/pro/v1"
+	"github.com/hhh0pE/go-coinmarketcap/v2/types"
+)
+
+// exchangeWorkers bounds how many exchange-rankings pages are fetched
+// concurrently.
+const exchangeWorkers = 4
+
+// Exchanges walks every page of the exchange rankings. It is equivalent to
+// ExchangesWithContext(context.Background()).
+func Exchanges() ([]*types.Exchange, error) {
+	return ExchangesWithContext(context.Background())
+}
+
+// ExchangesWithContext walks the exchange rankings in batches of
+// exchangeWorkers concurrent page fetches (each via
+// ExchangesByPageWithContext, so the Pro API fallback applies), stopping
+// after a batch in which a page comes back empty or no page succeeds. A page
+// that fails to fetch or parse is recorded and skipped rather than aborting
+// the whole crawl; ExchangesWithContext returns whatever pages did succeed
+// alongside an aggregated error describing the rest.
+func ExchangesWithContext(ctx context.Context) ([]*types.Exchange, error) {
+	var (
+		exchanges []*types.Exchange
+		errs      []error
+	)
+
+	for batchStart := 1; ; batchStart += exchangeWorkers {
+		type pageResult struct {
+			page      int
+			exchanges []*types.Exchange
+			err       error
+		}
+		results := make([]pageResult, exchangeWorkers)
+		var wg sync.WaitGroup
+		for i := 0; i < exchangeWorkers; i++ {
+			page := batchStart + i
+			wg.Add(1)
+			go func(i, page int) {
+				defer wg.Done()
+				pageExchanges, err := ExchangesByPageWithContext(ctx, page)
+				results[i] = pageResult{page: page, exchanges: pageExchanges, err: err}
+			}(i, page)
+		}
+		wg.Wait()
+
+		batchEmpty := false
+		batchGot := false
+		for _, r := range results {
+			switch {
+			case r.err != nil:
+				errs = append(errs, fmt.Errorf("page %d: %w", r.page, r.err))
+			case len(r.exchanges) == 0:
+				batchEmpty = true
+			default:
+				batchGot = true
+				exchanges = append(exchanges, r.exchanges...)
+			}
+		}
+		if batchEmpty || !batchGot || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if len(errs) == 0 {
+		return exchanges, nil
+	}
+	return exchanges, fmt.Errorf("exchanges: %d page(s) failed: %w", len(errs), joinErrors(errs))
+}
+
+// ExchangesByPage gets one page of the exchange rankings. It is equivalent
+// to ExchangesByPageWithContext(context.Background(), page).
+func ExchangesByPage(page int) ([]*types.Exchange, error) {
+	return ExchangesByPageWithContext(context.Background(), page)
+}
+
+// ExchangesByPageWithContext gets one page of the exchange rankings,
+// retrying on rate-limit/server errors and falling back to the Pro API (see
+// SetProFallback) if the page fails to parse.
+func ExchangesByPageWithContext(ctx context.Context, page int) ([]*types.Exchange, error) {
+	exchanges, err := exchangesByPage(ctx, page)
+	if err == nil && (len(exchanges) > 0 || page > 1) {
+		return exchanges, nil
+	}
+
+	fallback, fbErr := exchangesFromProFallback(ctx, page)
+	if fbErr == nil {
+		return fallback, nil
+	}
+	if err != nil {
+		return exchanges, fmt.Errorf("exchanges: scrape failed (%v) and pro fallback failed: %w", err, fbErr)
+	}
+	return exchanges, nil
+}
+
+func exchangesByPage(ctx context.Context, page int) ([]*types.Exchange, error) {
+	if page < 1 {
+		page = 1
+	}
+	pageURL := fmt.Sprintf("%s/rankings/exchanges/%d", siteURL, page)
+
+	body, err := fetchCachedPage(ctx, "exchanges", pageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	table := doc.Find("table#exchange-rankings")
+	idx := buildColumnIndex(headerTexts(table), classifyExchangeHeader)
+
+	var exchanges []*types.Exchange
+	table.Find("tbody tr").Each(func(_ int, tr *goquery.Selection) {
+		var row []string
+		var slug, logo string
+		tr.Find("td").Each(func(_ int, td *goquery.Selection) {
+			if val, exists := td.Attr("data-sort"); exists {
+				row = append(row, val)
+			} else {
+				row = append(row, strings.TrimSpace(td.Text()))
+			}
+			if href, exists := td.Find("a").Attr("href"); exists {
+				parts := strings.Split(strings.TrimSuffix(href, "/"), "/")
+				slug = parts[len(parts)-1]
+			}
+			if src, exists := td.Find("img").Attr("src"); exists {
+				logo = src
+			}
+		})
+		exchanges = append(exchanges, &types.Exchange{
+			Rank:            toInt(idx.get(row, "rank")),
+			Name:            idx.get(row, "name"),
+			Slug:            slug,
+			LogoImg:         logo,
+			AdjustVolume24h: toFloat(idx.get(row, "adjvolume24h")),
+			Volume24h:       toFloat(idx.get(row, "volume24h")),
+			Volume7d:        toFloat(idx.get(row, "volume7d")),
+			Volume30d:       toFloat(idx.get(row, "volume30d")),
+			MarketsNumber:   toInt(idx.get(row, "markets")),
+			Change24h:       toFloat(idx.get(row, "change24h")) / 100,
+			LaunchedAt:      toDate(idx.get(row, "launched")),
+		})
+	})
+	return exchanges, nil
+}
+
+func exchangesFromProFallback(ctx context.Context, page int) ([]*types.Exchange, error) {
+	if proFallback == nil {
+		return nil, errors.New("exchanges: no pro fallback configured")
+	}
+	if page > 1 {
+		// The Pro API paginates by start/limit, not page number; only the
+		// first page has a direct equivalent here.
+		return nil, errors.New("exchanges: pro fallback only covers the first page")
+	}
+
+	listings, err := proFallback.Exchange.LatestListings(ctx, &v1.ExchangeListingsOptions{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	exchanges := make([]*types.Exchange, 0, len(listings))
+	for i, l := range listings {
+		quote := l.Quotes["USD"]
+		exchanges = append(exchanges, &types.Exchange{
+			Rank:      i + 1,
+			Name:      l.Name,
+			Slug:      l.Slug,
+			Volume24h: quote.Volume24h,
+		})
+	}
+	return exchanges, nil
+}
+
+// joinErrors flattens errs into a single error whose message lists each
+// underlying error.
+func joinErrors(errs []error) error {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
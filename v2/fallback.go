@@ -0,0 +1,14 @@
+package coinmarketcap
+
+import v1 "github.com/hhh0pE/go-coinmarketcap/pro/v1"
+
+// proFallback, when set, is used by MarketsWithContext/ExchangesWithContext
+// to serve a Pro API response whenever scraping CMC's HTML fails to parse.
+var proFallback *v1.Client
+
+// SetProFallback configures the Pro API client MarketsWithContext and
+// ExchangesWithContext fall back to when scraping fails. Pass nil to
+// disable the fallback.
+func SetProFallback(client *v1.Client) {
+	proFallback = client
+}
@@ -0,0 +1,139 @@
+package coinmarketcap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	v1 "github.com/hhh0pE/go-coinmarketcap/pro/v1"
+	"github.com/hhh0pE/go-coinmarketcap/v2/types"
+)
+
+// Markets get market data for a cryptocurrency. It is equivalent to
+// MarketsWithContext(context.Background(), options).
+func Markets(options *MarketsOptions) ([]*types.Market, error) {
+	return MarketsWithContext(context.Background(), options)
+}
+
+// MarketsWithContext gets market data for a cryptocurrency by parsing its
+// markets table by column header rather than position, retrying on
+// rate-limit/server errors, and falling back to the Pro API (see
+// SetProFallback) if the page fails to parse.
+func MarketsWithContext(ctx context.Context, options *MarketsOptions) ([]*types.Market, error) {
+	if options == nil {
+		return nil, errors.New("nil options")
+	}
+	if options.Slug == "" && options.Symbol == "" {
+		return nil, errors.New("empty slug and Symbol")
+	}
+
+	slug := options.Slug
+	if slug == "" {
+		resolved, err := CoinSlug(options.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		slug = resolved
+	}
+
+	pageURL := fmt.Sprintf("%s/currencies/%s/#markets", siteURL, slug)
+	markets, err := fetchMarketsPage(ctx, pageURL)
+	if err == nil && len(markets) > 0 {
+		return markets, nil
+	}
+
+	fallback, fbErr := marketsFromProFallback(ctx, options)
+	if fbErr == nil {
+		return fallback, nil
+	}
+	if err != nil {
+		return markets, fmt.Errorf("markets: scrape failed (%v) and pro fallback failed: %w", err, fbErr)
+	}
+	return markets, nil
+}
+
+func fetchMarketsPage(ctx context.Context, pageURL string) ([]*types.Market, error) {
+	body, err := fetchCachedPage(ctx, "markets", pageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	table := doc.Find("table#markets-table")
+	idx := buildColumnIndex(headerTexts(table), classifyMarketHeader)
+
+	var markets []*types.Market
+	table.Find("tbody tr").Each(func(_ int, tr *goquery.Selection) {
+		var row []string
+		var exchangeSlug string
+		tr.Find("td").Each(func(_ int, td *goquery.Selection) {
+			if val, exists := td.Attr("data-sort"); exists {
+				row = append(row, val)
+			} else {
+				row = append(row, strings.TrimSpace(td.Text()))
+			}
+			if href, exists := td.Find("a").Attr("href"); exists {
+				parts := strings.Split(strings.TrimSuffix(href, "/"), "/")
+				exchangeSlug = parts[len(parts)-1]
+			}
+		})
+		markets = append(markets, &types.Market{
+			Rank:          toInt(idx.get(row, "rank")),
+			Exchange:      idx.get(row, "exchange"),
+			ExchangeSlug:  exchangeSlug,
+			Pair:          idx.get(row, "pair"),
+			VolumeUSD:     toFloat(idx.get(row, "volume")),
+			Price:         toFloat(idx.get(row, "price")),
+			VolumePercent: toFloat(idx.get(row, "volumepercent")),
+			Category:      idx.get(row, "category"),
+			FeeType:       idx.get(row, "feetype"),
+			Updated:       idx.get(row, "updated"),
+		})
+	})
+	return markets, nil
+}
+
+// headerTexts extracts the header cell text of table's first row, whether
+// or not it's wrapped in a <thead>.
+func headerTexts(table *goquery.Selection) []string {
+	headerRow := table.Find("thead tr").First()
+	if headerRow.Length() == 0 {
+		headerRow = table.Find("tr").First()
+	}
+	var headers []string
+	headerRow.Find("th, td").Each(func(_ int, cell *goquery.Selection) {
+		headers = append(headers, cell.Text())
+	})
+	return headers
+}
+
+func marketsFromProFallback(ctx context.Context, options *MarketsOptions) ([]*types.Market, error) {
+	if proFallback == nil {
+		return nil, errors.New("markets: no pro fallback configured")
+	}
+	result, err := proFallback.Cryptocurrency.MarketPairsLatest(ctx, &v1.MarketPairsOptions{Symbol: options.Symbol})
+	if err != nil {
+		return nil, err
+	}
+
+	markets := make([]*types.Market, 0, len(result.MarketPairs))
+	for i, mp := range result.MarketPairs {
+		quote := mp.Quotes["USD"]
+		markets = append(markets, &types.Market{
+			Rank:         i + 1,
+			Exchange:     mp.Exchange.Name,
+			ExchangeSlug: mp.Exchange.Slug,
+			Pair:         mp.MarketPair,
+			VolumeUSD:    quote.Volume24h,
+			Price:        quote.Price,
+		})
+	}
+	return markets, nil
+}
@@ -0,0 +1,197 @@
+package coinmarketcap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/hhh0pE/go-coinmarketcap/pro/v1"
+	"github.com/hhh0pE/go-coinmarketcap/v2/types"
+)
+
+// validIntervals are the interval values accepted by OHLCVHistorical and
+// HistoricalQuotes.
+var validIntervals = map[string]bool{
+	"5m": true, "10m": true, "15m": true, "30m": true, "45m": true,
+	"1h": true, "2h": true, "3h": true, "4h": true, "6h": true, "12h": true,
+	"daily": true, "weekly": true, "monthly": true, "yearly": true,
+}
+
+// errNoProFallback is returned by the OHLCV/historical-quote helpers, which
+// have no public-v2 equivalent and depend entirely on a Pro API client
+// configured via SetProFallback.
+var errNoProFallback = errors.New("cmc: requires a Pro API client, see SetProFallback")
+
+// OHLCVLatest gets the latest OHLCV candle for a cryptocurrency, converted
+// into convert. It is equivalent to
+// OHLCVLatestWithContext(context.Background(), symbol, convert).
+//
+// It requires a Pro API client configured via SetProFallback and returns
+// errNoProFallback until one is set.
+func OHLCVLatest(symbol, convert string) (*types.OHLCV, error) {
+	return OHLCVLatestWithContext(context.Background(), symbol, convert)
+}
+
+// OHLCVLatestWithContext gets the latest OHLCV candle for a cryptocurrency,
+// converted into convert.
+//
+// It requires a Pro API client configured via SetProFallback and returns
+// errNoProFallback until one is set.
+func OHLCVLatestWithContext(ctx context.Context, symbol, convert string) (*types.OHLCV, error) {
+	if proFallback == nil {
+		return nil, errNoProFallback
+	}
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if convert == "" {
+		convert = "USD"
+	}
+
+	result, err := proFallback.Cryptocurrency.OHLCVLatest(ctx, &v1.OHLCVOptions{
+		Symbol:  []string{symbol},
+		Convert: convert,
+	})
+	if err != nil {
+		return nil, err
+	}
+	quote, ok := result[symbol]
+	if !ok {
+		return nil, fmt.Errorf("ohlcv: no data for %s", symbol)
+	}
+	candle, ok := quote.Quotes[strings.ToUpper(convert)]
+	if !ok {
+		return nil, fmt.Errorf("ohlcv: no %s quote for %s", convert, symbol)
+	}
+	return &types.OHLCV{
+		Open:      candle.Open,
+		High:      candle.High,
+		Low:       candle.Low,
+		Close:     candle.Close,
+		Volume:    candle.Volume,
+		MarketCap: candle.MarketCap,
+		Timestamp: candle.Timestamp,
+	}, nil
+}
+
+// OHLCVHistorical gets a historical OHLCV candle series for a
+// cryptocurrency, converted into convert. It is equivalent to
+// OHLCVHistoricalWithContext(context.Background(), ...).
+//
+// It requires a Pro API client configured via SetProFallback and returns
+// errNoProFallback until one is set.
+func OHLCVHistorical(symbol, convert string, timeStart, timeEnd time.Time, interval string, count int) ([]*types.OHLCV, error) {
+	return OHLCVHistoricalWithContext(context.Background(), symbol, convert, timeStart, timeEnd, interval, count)
+}
+
+// OHLCVHistoricalWithContext gets a historical OHLCV candle series for a
+// cryptocurrency, converted into convert.
+//
+// It requires a Pro API client configured via SetProFallback and returns
+// errNoProFallback until one is set.
+func OHLCVHistoricalWithContext(ctx context.Context, symbol, convert string, timeStart, timeEnd time.Time, interval string, count int) ([]*types.OHLCV, error) {
+	if proFallback == nil {
+		return nil, errNoProFallback
+	}
+	if interval != "" && !validIntervals[interval] {
+		return nil, fmt.Errorf("ohlcv: unsupported interval %q", interval)
+	}
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if convert == "" {
+		convert = "USD"
+	}
+
+	result, err := proFallback.Cryptocurrency.OHLCVHistorical(ctx, &v1.OHLCVHistoricalOptions{
+		Symbol:    []string{symbol},
+		Convert:   convert,
+		TimeStart: timeStart,
+		TimeEnd:   timeEnd,
+		Interval:  interval,
+		Count:     count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	series, ok := result[symbol]
+	if !ok {
+		return nil, fmt.Errorf("ohlcv: no data for %s", symbol)
+	}
+
+	candles := make([]*types.OHLCV, 0, len(series.Quotes))
+	for _, point := range series.Quotes {
+		candle, ok := point.Quotes[strings.ToUpper(convert)]
+		if !ok {
+			continue
+		}
+		candles = append(candles, &types.OHLCV{
+			Open:      candle.Open,
+			High:      candle.High,
+			Low:       candle.Low,
+			Close:     candle.Close,
+			Volume:    candle.Volume,
+			MarketCap: candle.MarketCap,
+			Timestamp: point.TimeOpen,
+		})
+	}
+	return candles, nil
+}
+
+// HistoricalQuotes gets interval-spaced historical quotes for a
+// cryptocurrency, converted into convert. It is equivalent to
+// HistoricalQuotesWithContext(context.Background(), ...).
+//
+// It requires a Pro API client configured via SetProFallback and returns
+// errNoProFallback until one is set.
+func HistoricalQuotes(symbol, convert string, start, end time.Time, interval string) ([]types.Quote, error) {
+	return HistoricalQuotesWithContext(context.Background(), symbol, convert, start, end, interval)
+}
+
+// HistoricalQuotesWithContext gets interval-spaced historical quotes for a
+// cryptocurrency, converted into convert.
+//
+// It requires a Pro API client configured via SetProFallback and returns
+// errNoProFallback until one is set.
+func HistoricalQuotesWithContext(ctx context.Context, symbol, convert string, start, end time.Time, interval string) ([]types.Quote, error) {
+	if proFallback == nil {
+		return nil, errNoProFallback
+	}
+	if interval != "" && !validIntervals[interval] {
+		return nil, fmt.Errorf("historical quotes: unsupported interval %q", interval)
+	}
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if convert == "" {
+		convert = "USD"
+	}
+
+	result, err := proFallback.Cryptocurrency.HistoricalQuotes(ctx, &v1.HistoricalQuotesOptions{
+		Symbol:    []string{symbol},
+		Convert:   convert,
+		TimeStart: start,
+		TimeEnd:   end,
+		Interval:  interval,
+	})
+	if err != nil {
+		return nil, err
+	}
+	series, ok := result[symbol]
+	if !ok {
+		return nil, fmt.Errorf("historical quotes: no data for %s", symbol)
+	}
+
+	quotes := make([]types.Quote, 0, len(series.Quotes))
+	for _, point := range series.Quotes {
+		proQuote, ok := point.Quote[strings.ToUpper(convert)]
+		if !ok {
+			continue
+		}
+		quotes = append(quotes, types.Quote{
+			Price:            proQuote.Price,
+			Volume24h:        proQuote.Volume24h,
+			MarketCap:        proQuote.MarketCap,
+			PercentChange1h:  proQuote.PercentChange1h,
+			PercentChange24h: proQuote.PercentChange24h,
+			PercentChange7d:  proQuote.PercentChange7d,
+		})
+	}
+	return quotes, nil
+}
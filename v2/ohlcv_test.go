@@ -0,0 +1,91 @@
+package coinmarketcap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	v1 "github.com/hhh0pE/go-coinmarketcap/pro/v1"
+)
+
+func TestOHLCVLatestErrorsWithoutProFallback(t *testing.T) {
+	SetProFallback(nil)
+
+	if _, err := OHLCVLatest("BTC", "USD"); err != errNoProFallback {
+		t.Fatalf("OHLCVLatest() error = %v, want errNoProFallback", err)
+	}
+}
+
+func TestOHLCVLatestUsesProFallback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"error_code":0},"data":{"BTC":{"id":1,"symbol":"BTC","quote":{"USD":{"open":1,"high":2,"low":0.5,"close":1.5,"volume":100,"market_cap":1000}}}}}`))
+	}))
+	defer srv.Close()
+	SetProFallback(newTestProClient(srv))
+	defer SetProFallback(nil)
+
+	candle, err := OHLCVLatest("btc", "USD")
+	if err != nil {
+		t.Fatalf("OHLCVLatest() error = %v", err)
+	}
+	if candle.Close != 1.5 {
+		t.Errorf("Close = %v, want 1.5", candle.Close)
+	}
+}
+
+func TestOHLCVHistoricalRejectsUnsupportedInterval(t *testing.T) {
+	SetProFallback(newTestProClient(httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("should not reach the network for an invalid interval")
+	}))))
+	defer SetProFallback(nil)
+
+	if _, err := OHLCVHistorical("BTC", "USD", time.Time{}, time.Time{}, "bogus", 0); err == nil {
+		t.Fatal("OHLCVHistorical() error = nil, want an error for an unsupported interval")
+	}
+}
+
+func TestHistoricalQuotesErrorsWithoutProFallback(t *testing.T) {
+	SetProFallback(nil)
+
+	if _, err := HistoricalQuotes("BTC", "USD", time.Time{}, time.Time{}, "daily"); err != errNoProFallback {
+		t.Fatalf("HistoricalQuotes() error = %v, want errNoProFallback", err)
+	}
+}
+
+func TestHistoricalQuotesMissingSymbolData(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":{"error_code":0},"data":{}}`))
+	}))
+	defer srv.Close()
+	SetProFallback(newTestProClient(srv))
+	defer SetProFallback(nil)
+
+	if _, err := HistoricalQuotes("BTC", "USD", time.Time{}, time.Time{}, "daily"); err == nil {
+		t.Fatal("HistoricalQuotes() error = nil, want an error when the symbol is absent from the response")
+	}
+}
+
+// newTestProClient builds a Pro API client whose requests are rewritten onto
+// srv, regardless of the real Pro API host baked into v1.NewClient.
+func newTestProClient(srv *httptest.Server) *v1.Client {
+	return v1.NewClient(&v1.Config{
+		ProAPIKey:  "test",
+		HTTPClient: &http.Client{Transport: redirectToServer{srv.URL}},
+	})
+}
+
+type redirectToServer struct {
+	baseURL string
+}
+
+func (rt redirectToServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(rt.baseURL)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Scheme = target.Scheme
+	req.URL.Host = target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
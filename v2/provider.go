@@ -0,0 +1,75 @@
+package coinmarketcap
+
+import (
+	"github.com/hhh0pE/go-coinmarketcap/providers"
+	"github.com/hhh0pE/go-coinmarketcap/v2/types"
+)
+
+// Client adapts the package-level CMC functions to the providers.Provider
+// interface, so this backend can be swapped with any other.
+type Client struct{}
+
+// NewClient returns a Provider backed by the CoinMarketCap v2 endpoints.
+func NewClient() *Client {
+	return &Client{}
+}
+
+var _ providers.Provider = (*Client)(nil)
+
+// Tickers implements providers.Provider.
+func (c *Client) Tickers(options *providers.TickersOptions) ([]*types.Ticker, error) {
+	return Tickers(&TickersOptions{
+		Start:   options.Start,
+		Limit:   options.Limit,
+		Convert: options.Convert,
+		Sort:    options.Sort,
+	})
+}
+
+// Ticker implements providers.Provider.
+func (c *Client) Ticker(options *providers.TickerOptions) (*types.Ticker, error) {
+	return Ticker(&TickerOptions{
+		Symbol:  options.Symbol,
+		Convert: options.Convert,
+	})
+}
+
+// TickerGraph implements providers.Provider.
+func (c *Client) TickerGraph(options *providers.TickerGraphOptions) (*types.TickerGraph, error) {
+	return TickerGraph(&TickerGraphOptions{
+		Symbol: options.Symbol,
+		Start:  options.Start,
+		End:    options.End,
+	})
+}
+
+// GlobalMarket implements providers.Provider.
+func (c *Client) GlobalMarket(options *providers.GlobalMarketOptions) (*types.GlobalMarket, error) {
+	return GlobalMarket(&GlobalMarketOptions{Convert: options.Convert})
+}
+
+// Markets implements providers.Provider.
+func (c *Client) Markets(options *providers.MarketsOptions) ([]*types.Market, error) {
+	return Markets(&MarketsOptions{
+		Symbol: options.Symbol,
+		Slug:   options.Slug,
+	})
+}
+
+// Price implements providers.Provider.
+func (c *Client) Price(options *providers.PriceOptions) (float64, error) {
+	return Price(&PriceOptions{
+		Symbol:  options.Symbol,
+		Convert: options.Convert,
+	})
+}
+
+// Listings implements providers.Provider.
+func (c *Client) Listings() ([]*types.Listing, error) {
+	return Listings()
+}
+
+// Exchanges implements providers.Provider.
+func (c *Client) Exchanges() ([]*types.Exchange, error) {
+	return Exchanges()
+}
@@ -0,0 +1,60 @@
+package coinmarketcap
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// retryPolicy controls doWithRetry's exponential backoff.
+type retryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+var defaultRetryPolicy = retryPolicy{MaxAttempts: 4, BaseDelay: 500 * time.Millisecond}
+
+// isRetryableStatus reports whether statusCode deserves a retry rather than being
+// treated as a final failure.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the delay before retry attempt (0-indexed), exponential
+// in attempt with up to 50% jitter to avoid a thundering herd against CMC.
+func backoff(attempt int, base time.Duration) time.Duration {
+	d := base * time.Duration(1<<uint(attempt))
+	jitter := time.Duration(rand.Int63n(int64(d)/2 + 1))
+	return d + jitter
+}
+
+// doWithRetry runs req, retrying on network errors and retryable status
+// codes with exponential backoff + jitter, honoring ctx cancellation
+// between attempts.
+func doWithRetry(ctx context.Context, client *http.Client, req *http.Request, policy retryPolicy) (*http.Response, error) {
+	var lastErr error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff(attempt-1, policy.BaseDelay)):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		resp, err := client.Do(req.Clone(ctx))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if isRetryableStatus(resp.StatusCode) {
+			resp.Body.Close()
+			lastErr = fmt.Errorf("cmc: retryable status %s", resp.Status)
+			continue
+		}
+		return resp, nil
+	}
+	return nil, lastErr
+}
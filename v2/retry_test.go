@@ -0,0 +1,105 @@
+package coinmarketcap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableStatus(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusOK:                  false,
+		http.StatusNotFound:            false,
+		http.StatusTooManyRequests:     true,
+		http.StatusInternalServerError: true,
+		http.StatusBadGateway:          true,
+	}
+	for status, want := range cases {
+		if got := isRetryableStatus(status); got != want {
+			t.Errorf("isRetryableStatus(%d) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestBackoffIsBoundedAndGrows(t *testing.T) {
+	base := 100 * time.Millisecond
+	for attempt := 0; attempt < 4; attempt++ {
+		d := backoff(attempt, base)
+		min := base * time.Duration(1<<uint(attempt))
+		max := min + min/2
+		if d < min || d > max {
+			t.Errorf("backoff(%d, %v) = %v, want within [%v, %v]", attempt, base, d, min, max)
+		}
+	}
+}
+
+func TestDoWithRetryRetriesRetryableStatus(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := retryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond}
+	resp, err := doWithRetry(context.Background(), srv.Client(), req, policy)
+	if err != nil {
+		t.Fatalf("doWithRetry() error = %v", err)
+	}
+	resp.Body.Close()
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestDoWithRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := retryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	_, err = doWithRetry(context.Background(), srv.Client(), req, policy)
+	if err == nil {
+		t.Fatal("doWithRetry() error = nil, want a retryable-status error")
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestDoWithRetryHonorsContextCancellation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := retryPolicy{MaxAttempts: 3, BaseDelay: 50 * time.Millisecond}
+	_, err = doWithRetry(ctx, srv.Client(), req, policy)
+	if err != ctx.Err() {
+		t.Errorf("doWithRetry() error = %v, want %v", err, ctx.Err())
+	}
+}
@@ -0,0 +1,133 @@
+package coinmarketcap
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/hhh0pE/go-coinmarketcap/pro/v1"
+)
+
+// symbols is the package's lazy singleton symbol index. It is populated on
+// first use (and periodically thereafter) so a Ticker lookup no longer has
+// to fetch the full coin listing on every call.
+var symbols = &symbolIndex{}
+
+// defaultSymbolRefreshInterval is how long a populated index is trusted
+// before the next lookup triggers a rebuild.
+const defaultSymbolRefreshInterval = 24 * time.Hour
+
+// symbolIndex maps ticker symbols to coin IDs and coin IDs to slugs,
+// rebuilding itself from /v1/cryptocurrency/map (or, without a Pro API
+// client, the full v2 listing) no more often than refreshInterval.
+type symbolIndex struct {
+	mu              sync.RWMutex
+	bySymbol        map[string]int
+	slugByID        map[int]string
+	lastRefresh     time.Time
+	refreshInterval time.Duration
+}
+
+// SetSymbolRefreshInterval overrides how long the symbol index is trusted
+// before CoinID/CoinSlug trigger a rebuild. The default is 24h.
+func SetSymbolRefreshInterval(d time.Duration) {
+	symbols.mu.Lock()
+	defer symbols.mu.Unlock()
+	symbols.refreshInterval = d
+}
+
+// RefreshSymbols forces an immediate rebuild of the symbol index, ignoring
+// its age.
+func RefreshSymbols() error {
+	return symbols.refresh()
+}
+
+func (idx *symbolIndex) coinID(symbol string) (int, error) {
+	if err := idx.ensureFresh(); err != nil {
+		return 0, err
+	}
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	id, ok := idx.bySymbol[symbol]
+	if !ok {
+		return 0, errors.New("coin not found")
+	}
+	return id, nil
+}
+
+func (idx *symbolIndex) slug(id int) (string, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	slug, ok := idx.slugByID[id]
+	if !ok {
+		return "", errors.New("coin not found")
+	}
+	return slug, nil
+}
+
+func (idx *symbolIndex) ensureFresh() error {
+	idx.mu.RLock()
+	interval := idx.refreshInterval
+	if interval <= 0 {
+		interval = defaultSymbolRefreshInterval
+	}
+	stale := idx.lastRefresh.IsZero() || time.Since(idx.lastRefresh) > interval
+	idx.mu.RUnlock()
+	if !stale {
+		return nil
+	}
+	return idx.refresh()
+}
+
+func (idx *symbolIndex) refresh() error {
+	bySymbol, slugByID, err := buildSymbolIndex()
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	idx.bySymbol = bySymbol
+	idx.slugByID = slugByID
+	idx.lastRefresh = time.Now()
+	idx.mu.Unlock()
+	return nil
+}
+
+// buildSymbolIndex prefers the Pro API's cryptocurrency map (see
+// SetProFallback) since it's a dedicated, lightweight endpoint; it falls
+// back to the full v2 listing otherwise. Coins sharing a symbol resolve to
+// their lowest ID; callers can disambiguate with TickerByID.
+func buildSymbolIndex() (map[string]int, map[int]string, error) {
+	if proFallback != nil {
+		items, err := proFallback.Cryptocurrency.Map(context.Background(), &v1.CryptocurrencyMapOptions{ListingStatus: "active"})
+		if err == nil {
+			bySymbol := make(map[string]int, len(items))
+			slugByID := make(map[int]string, len(items))
+			for _, item := range items {
+				indexSymbol(bySymbol, strings.ToUpper(item.Symbol), item.ID)
+				slugByID[item.ID] = item.Slug
+			}
+			return bySymbol, slugByID, nil
+		}
+	}
+
+	listings, err := Listings()
+	if err != nil {
+		return nil, nil, err
+	}
+	bySymbol := make(map[string]int, len(listings))
+	slugByID := make(map[int]string, len(listings))
+	for _, l := range listings {
+		indexSymbol(bySymbol, strings.ToUpper(l.Symbol), l.ID)
+		slugByID[l.ID] = l.WebsiteSlug
+	}
+	return bySymbol, slugByID, nil
+}
+
+func indexSymbol(bySymbol map[string]int, symbol string, id int) {
+	if existing, ok := bySymbol[symbol]; !ok || id < existing {
+		bySymbol[symbol] = id
+	}
+}
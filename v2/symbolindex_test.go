@@ -0,0 +1,50 @@
+package coinmarketcap
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIndexSymbolResolvesCollisionToLowestID(t *testing.T) {
+	bySymbol := make(map[string]int)
+	indexSymbol(bySymbol, "HOT", 2682)
+	indexSymbol(bySymbol, "HOT", 512)
+	indexSymbol(bySymbol, "HOT", 9999)
+
+	if got := bySymbol["HOT"]; got != 512 {
+		t.Errorf("bySymbol[HOT] = %d, want 512 (the lowest of the colliding IDs)", got)
+	}
+}
+
+func TestIndexSymbolFirstInsert(t *testing.T) {
+	bySymbol := make(map[string]int)
+	indexSymbol(bySymbol, "BTC", 1)
+
+	if got, ok := bySymbol["BTC"]; !ok || got != 1 {
+		t.Errorf("bySymbol[BTC] = %d, %v, want 1, true", got, ok)
+	}
+}
+
+func TestSymbolIndexCoinIDAndSlug(t *testing.T) {
+	idx := &symbolIndex{
+		bySymbol:    map[string]int{"BTC": 1},
+		slugByID:    map[int]string{1: "bitcoin"},
+		lastRefresh: time.Now(),
+	}
+
+	id, err := idx.coinID("BTC")
+	if err != nil || id != 1 {
+		t.Fatalf("coinID(BTC) = %d, %v, want 1, nil", id, err)
+	}
+	slug, err := idx.slug(id)
+	if err != nil || slug != "bitcoin" {
+		t.Fatalf("slug(1) = %q, %v, want %q, nil", slug, err, "bitcoin")
+	}
+
+	if _, err := idx.coinID("NOPE"); err == nil {
+		t.Error("coinID(NOPE) error = nil, want an error for an unknown symbol")
+	}
+	if _, err := idx.slug(999); err == nil {
+		t.Error("slug(999) error = nil, want an error for an unknown ID")
+	}
+}
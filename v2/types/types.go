@@ -0,0 +1,106 @@
+// Package types holds the data shapes shared by the coinmarketcap v2
+// package and its providers.
+package types
+
+import "time"
+
+// Listing is one entry of the full coin listing, used to translate a
+// ticker symbol into a numeric coin ID.
+type Listing struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	WebsiteSlug string `json:"website_slug"`
+}
+
+// Quote is a cryptocurrency's market data converted into one currency.
+type Quote struct {
+	Price            float64 `json:"price"`
+	Volume24h        float64 `json:"volume_24h"`
+	MarketCap        float64 `json:"market_cap"`
+	PercentChange1h  float64 `json:"percent_change_1h"`
+	PercentChange24h float64 `json:"percent_change_24h"`
+	PercentChange7d  float64 `json:"percent_change_7d"`
+}
+
+// Ticker is a cryptocurrency and its market data.
+type Ticker struct {
+	ID                int              `json:"id"`
+	Name              string           `json:"name"`
+	Symbol            string           `json:"symbol"`
+	Slug              string           `json:"website_slug"`
+	Rank              int              `json:"rank"`
+	CirculatingSupply float64          `json:"circulating_supply"`
+	TotalSupply       float64          `json:"total_supply"`
+	MaxSupply         float64          `json:"max_supply"`
+	LastUpdated       int64            `json:"last_updated"`
+	Quotes            map[string]Quote `json:"quotes"`
+}
+
+// TickerGraph is a set of historical data points for a single
+// cryptocurrency, as served by the legacy graphs2 endpoint.
+type TickerGraph struct {
+	MarketCapByAvailableSupply [][]float64 `json:"market_cap_by_available_supply"`
+	PriceBTC                   [][]float64 `json:"price_btc"`
+	PriceUSD                   [][]float64 `json:"price_usd"`
+	Volume                     [][]float64 `json:"volume_usd"`
+}
+
+// MarketGraph is a set of historical data points for the global market, as
+// served by the legacy graphs2 endpoint.
+type MarketGraph struct {
+	MarketCapByAvailableSupply [][]float64 `json:"market_cap_by_available_supply"`
+	Volume                     [][]float64 `json:"volume_usd"`
+}
+
+// GlobalMarket is the aggregate state of the cryptocurrency market.
+type GlobalMarket struct {
+	TotalMarketCap            float64          `json:"total_market_cap"`
+	Total24hVolume            float64          `json:"total_24h_volume"`
+	BitcoinPercentageOfMarket float64          `json:"bitcoin_percentage_of_market_cap"`
+	ActiveCurrencies          int              `json:"active_currencies"`
+	ActiveMarkets             int              `json:"active_markets"`
+	ActiveAssets              int              `json:"active_assets"`
+	LastUpdated               int64            `json:"last_updated"`
+	Quotes                    map[string]Quote `json:"quotes"`
+}
+
+// Market is one exchange/pair a cryptocurrency trades on.
+type Market struct {
+	Rank          int
+	Exchange      string
+	ExchangeSlug  string
+	Pair          string
+	VolumeUSD     float64
+	Price         float64
+	VolumePercent float64
+	Category      string
+	FeeType       string
+	Updated       string
+}
+
+// OHLCV is a single open/high/low/close/volume candle.
+type OHLCV struct {
+	Open      float64   `json:"open"`
+	High      float64   `json:"high"`
+	Low       float64   `json:"low"`
+	Close     float64   `json:"close"`
+	Volume    float64   `json:"volume"`
+	MarketCap float64   `json:"market_cap"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Exchange is a ranked cryptocurrency exchange.
+type Exchange struct {
+	Rank            int
+	Name            string
+	Slug            string
+	LogoImg         string
+	AdjustVolume24h float64
+	Volume24h       float64
+	Volume7d        float64
+	Volume30d       float64
+	MarketsNumber   int
+	Change24h       float64
+	LaunchedAt      time.Time
+}
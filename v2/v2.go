@@ -14,8 +14,6 @@ import (
 
 	"sort"
 
-	"github.com/PuerkitoBio/goquery"
-	"github.com/anaskhan96/soup"
 	"github.com/hhh0pE/go-coinmarketcap/v2/types"
 )
 
@@ -50,7 +48,7 @@ type listingsMedia struct {
 // Listings gets all coin listings
 func Listings() ([]*types.Listing, error) {
 	url := fmt.Sprintf("%s/listings", baseURL)
-	resp, err := makeReq(url)
+	resp, err := makeCachedReq("listings", url)
 	var body listingsMedia
 	err = json.Unmarshal(resp, &body)
 	if err != nil {
@@ -93,7 +91,7 @@ func Tickers(options *TickersOptions) ([]*types.Ticker, error) {
 		params = append(params, fmt.Sprintf("sort=%v", options.Sort))
 	}
 	url := fmt.Sprintf("%s/ticker?%s", baseURL, strings.Join(params, "&"))
-	resp, err := makeReq(url)
+	resp, err := makeCachedReq("tickers", url)
 	var body tickersMedia
 	err = json.Unmarshal(resp, &body)
 	if err != nil {
@@ -126,18 +124,27 @@ type tickerMedia struct {
 	Data *types.Ticker `json:"data"`
 }
 
-// Ticker gets ticker information about a cryptocurrency
+// Ticker gets ticker information about a cryptocurrency. If Symbol is
+// shared by more than one coin (e.g. HOT, UNI), this resolves to whichever
+// has the lowest coin ID; use TickerByID to disambiguate.
 func Ticker(options *TickerOptions) (*types.Ticker, error) {
-	var params []string
-	if options.Convert != "" {
-		params = append(params, fmt.Sprintf("convert=%v", options.Convert))
-	}
 	id, err := CoinID(options.Symbol)
 	if err != nil {
 		return nil, err
 	}
+	return TickerByID(id, options.Convert)
+}
+
+// TickerByID gets ticker information for the cryptocurrency identified by
+// id, bypassing symbol lookup entirely. Use this to disambiguate a ticker
+// symbol shared by more than one coin.
+func TickerByID(id int, convert string) (*types.Ticker, error) {
+	var params []string
+	if convert != "" {
+		params = append(params, fmt.Sprintf("convert=%v", convert))
+	}
 	url := fmt.Sprintf("%s/ticker/%v?%s", baseURL, id, strings.Join(params, "&"))
-	resp, err := makeReq(url)
+	resp, err := makeCachedReq("ticker", url)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +199,7 @@ func GlobalMarket(options *GlobalMarketOptions) (*types.GlobalMarket, error) {
 		params = append(params, fmt.Sprintf("convert=%v", options.Convert))
 	}
 	url := fmt.Sprintf("%s/global?%s", baseURL, strings.Join(params, "&"))
-	resp, err := makeReq(url)
+	resp, err := makeCachedReq("global", url)
 	var body globalMarketMedia
 	err = json.Unmarshal(resp, &body)
 	if err != nil {
@@ -249,134 +256,6 @@ type MarketsOptions struct {
 	Slug   string
 }
 
-// Markets get market data for a cryptocurrency
-func Markets(options *MarketsOptions) ([]*types.Market, error) {
-	if options == nil {
-		return nil, errors.New("nil options")
-	}
-	if options.Slug == "" && options.Symbol == "" {
-		return nil, errors.New("empty slug and Symbol")
-	}
-
-	slug := options.Slug
-	if slug == "" {
-		var slug_err error
-		slug, slug_err = CoinSlug(options.Symbol)
-		if slug_err != nil {
-			return nil, slug_err
-		}
-	}
-	url := fmt.Sprintf("%s/currencies/%s/#markets", siteURL, slug)
-	var markets []*types.Market
-	response, err := soup.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	rows := soup.HTMLParse(response).Find("table", "id", "markets-table").Find("tbody").FindAll("tr")
-	for _, row := range rows {
-		var data []string
-		var exchangeSlug string
-		for ci, column := range row.FindAll("td") {
-			attrs := column.Attrs()
-			if attrs["data-sort"] != "" {
-				data = append(data, attrs["data-sort"])
-			} else {
-				data = append(data, column.Text())
-			}
-			if ci == 1 { // td #2
-				aAttrs := column.Find("a").Attrs()
-				if link, exist := aAttrs["href"]; exist {
-					link_parts := strings.Split(link, "/")
-					exchangeSlug = link_parts[len(link_parts)-1]
-				}
-			}
-		}
-		markets = append(markets, &types.Market{
-			Rank:          toInt(data[0]),
-			Exchange:      data[1],
-			ExchangeSlug:  exchangeSlug,
-			Pair:          data[2],
-			VolumeUSD:     toFloat(data[3]),
-			Price:         toFloat(data[4]),
-			VolumePercent: toFloat(data[5]),
-			Category:      data[6],
-			FeeType:       data[7],
-			Updated:       data[8],
-		})
-	}
-	return markets, nil
-}
-
-func Exchanges() ([]*types.Exchange, error) {
-	var exchanges []*types.Exchange
-	for i := 1; i > 0; i++ {
-		pageExchanges, err := ExchangesByPage(i)
-		if len(pageExchanges) == 0 {
-			break
-		}
-		if err != nil {
-			return nil, errors.New("Exchanges error: " + err.Error())
-		}
-		exchanges = append(exchanges, pageExchanges...)
-	}
-	return exchanges, nil
-}
-
-func ExchangesByPage(page int) ([]*types.Exchange, error) {
-	if page == 0 {
-		page = 1
-	}
-	url := fmt.Sprintf("%s/rankings/exchanges/%d", siteURL, page)
-	var exchanges []*types.Exchange
-
-	doc, err := goquery.NewDocument(url)
-	if err != nil {
-		return nil, err
-	}
-
-	trElems := doc.Find("table#exchange-rankings tbody tr")
-	for tri := 0; tri < trElems.Length(); tri++ {
-		tr := trElems.Eq(tri)
-
-		var data []string
-		var exchangeSlug string
-		var exchangeLogo string
-		tr.Find("td").Each(func(tdi int, selection *goquery.Selection) {
-			if val, exist := selection.Attr("data-sort"); exist {
-				data = append(data, val)
-			} else {
-				data = append(data, selection.Text())
-			}
-
-			if tdi == 1 {
-				if href, exist := selection.Find("a").Attr("href"); exist {
-					link_parts := strings.Split(strings.TrimSuffix(href, "/"), "/")
-					exchangeSlug = link_parts[len(link_parts)-1]
-				}
-				if src, exist := selection.Find("img").Attr("src"); exist {
-					exchangeLogo = src
-				}
-			}
-		})
-
-		exchanges = append(exchanges, &types.Exchange{
-			Rank:            toInt(data[0]),
-			Name:            data[1],
-			Slug:            exchangeSlug,
-			LogoImg:         exchangeLogo,
-			AdjustVolume24h: toFloat(data[2]),
-			Volume24h:       toFloat(data[3]),
-			Volume7d:        toFloat(data[4]),
-			Volume30d:       toFloat(data[5]),
-			MarketsNumber:   toInt(data[6]),
-			Change24h:       toFloat(data[7]) / 100,
-			LaunchedAt:      toDate(data[9]),
-		})
-	}
-
-	return exchanges, nil
-}
-
 // PriceOptions options for price method
 type PriceOptions struct {
 	Symbol  string
@@ -399,37 +278,24 @@ func Price(options *PriceOptions) (float64, error) {
 	return coin.Quotes[options.Convert].Price, nil
 }
 
-// CoinID gets the ID for the cryptocurrency
+// CoinID gets the ID for the cryptocurrency, served from the lazily-built
+// symbol index (see symbolindex.go) rather than fetching the full listing
+// on every call. If symbol is shared by more than one coin, this resolves
+// to whichever has the lowest coin ID.
 func CoinID(symbol string) (int, error) {
 	symbol = strings.ToUpper(strings.TrimSpace(symbol))
-	listings, err := Listings()
-	if err != nil {
-		return 0, err
-	}
-
-	for _, l := range listings {
-		if l.Symbol == symbol {
-			return l.ID, nil
-		}
-	}
-	//returns error as default
-	return 0, errors.New("coin not found")
+	return symbols.coinID(symbol)
 }
 
-// CoinSlug gets the slug for the cryptocurrency
+// CoinSlug gets the slug for the cryptocurrency, served from the same
+// symbol index as CoinID.
 func CoinSlug(symbol string) (string, error) {
 	symbol = strings.ToUpper(strings.TrimSpace(symbol))
-	coin, err := Ticker(&TickerOptions{
-		Symbol: symbol,
-	})
+	id, err := symbols.coinID(symbol)
 	if err != nil {
 		return "", err
 	}
-
-	if coin == nil {
-		return "", errors.New("coin not found")
-	}
-	return coin.Slug, nil
+	return symbols.slug(id)
 }
 
 // toInt helper for parsing strings to int